@@ -1,25 +1,30 @@
 module github.com/hashicorp/terraform-provider-aws
 
-go 1.20
+go 1.22
 
 require (
 	github.com/ProtonMail/go-crypto v0.0.0-20230217124315-7d5c6f04bbb8
-	github.com/aws/aws-sdk-go v1.44.305
-	github.com/aws/aws-sdk-go-v2 v1.19.0
+	github.com/aws/aws-sdk-go v1.55.5
+	github.com/aws/aws-sdk-go-v2 v1.36.3
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.13.5
 	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.19.15
 	github.com/aws/aws-sdk-go-v2/service/account v1.10.9
 	github.com/aws/aws-sdk-go-v2/service/acm v1.17.14
 	github.com/aws/aws-sdk-go-v2/service/appconfig v1.17.12
 	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.25.1
-	github.com/aws/aws-sdk-go-v2/service/cleanrooms v1.2.1
+	github.com/aws/aws-sdk-go-v2/service/bedrock v1.25.2
+	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.32.1
+	github.com/aws/aws-sdk-go-v2/service/cleanrooms v1.12.0
 	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.11.15
-	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.22.1
+	github.com/aws/aws-sdk-go-v2/service/cloudfront v1.44.0
+	github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore v1.8.10
+	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.45.0
 	github.com/aws/aws-sdk-go-v2/service/comprehend v1.24.5
 	github.com/aws/aws-sdk-go-v2/service/computeoptimizer v1.24.3
 	github.com/aws/aws-sdk-go-v2/service/directoryservice v1.17.4
 	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.1.13
-	github.com/aws/aws-sdk-go-v2/service/ec2 v1.105.1
+	github.com/aws/aws-sdk-go-v2/service/ec2 v1.193.0
+	github.com/aws/aws-sdk-go-v2/service/elasticache v1.44.0
 	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.9.0
 	github.com/aws/aws-sdk-go-v2/service/finspace v1.10.3
 	github.com/aws/aws-sdk-go-v2/service/fis v1.14.13
@@ -31,11 +36,12 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/ivschat v1.4.8
 	github.com/aws/aws-sdk-go-v2/service/kendra v1.41.1
 	github.com/aws/aws-sdk-go-v2/service/keyspaces v1.3.3
+	github.com/aws/aws-sdk-go-v2/service/lakeformation v1.41.3
 	github.com/aws/aws-sdk-go-v2/service/lambda v1.37.1
 	github.com/aws/aws-sdk-go-v2/service/lightsail v1.27.2
 	github.com/aws/aws-sdk-go-v2/service/medialive v1.32.1
 	github.com/aws/aws-sdk-go-v2/service/oam v1.1.14
-	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.2.7
+	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.14.0
 	github.com/aws/aws-sdk-go-v2/service/pipes v1.2.9
 	github.com/aws/aws-sdk-go-v2/service/pricing v1.20.1
 	github.com/aws/aws-sdk-go-v2/service/qldb v1.15.14
@@ -44,6 +50,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/resourceexplorer2 v1.2.16
 	github.com/aws/aws-sdk-go-v2/service/rolesanywhere v1.2.3
 	github.com/aws/aws-sdk-go-v2/service/route53domains v1.15.1
+	github.com/aws/aws-sdk-go-v2/service/route53profiles v1.0.7
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.31.9
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.1.14
 	github.com/aws/aws-sdk-go-v2/service/securitylake v1.5.0
@@ -102,20 +109,22 @@ require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v13 v13.0.0 // indirect
 	github.com/armon/go-radix v0.0.0-20180808171621-7fddfc383310 // indirect
-	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.4.10 // indirect
+	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.7 // indirect
 	github.com/aws/aws-sdk-go-v2/config v1.18.28 // indirect
 	github.com/aws/aws-sdk-go-v2/credentials v1.13.27 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.1.35 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.4.29 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.34 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.34 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.3.36 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.28 // indirect
 	github.com/aws/aws-sdk-go-v2/service/iam v1.21.1 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.7.29 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.9.29 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.12.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.14.4 // indirect
 	github.com/aws/aws-sdk-go-v2/service/sso v1.12.13 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.14.13 // indirect
 	github.com/aws/aws-sdk-go-v2/service/sts v1.19.3 // indirect
-	github.com/aws/smithy-go v1.13.5 // indirect
+	github.com/aws/smithy-go v1.22.2 // indirect
 	github.com/bgentry/speakeasy v0.1.0 // indirect
 	github.com/boombuler/barcode v1.0.1-0.20190219062509-6c824513bacc // indirect
 	github.com/cloudflare/circl v1.3.3 // indirect
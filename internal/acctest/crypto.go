@@ -23,6 +23,7 @@ import (
 const (
 	PEMBlockTypeCertificate        = `CERTIFICATE`
 	PEMBlockTypeCertificateRequest = `CERTIFICATE REQUEST`
+	PEMBlockTypeCRL                = `X509 CRL`
 	PEMBlockTypeECPrivateKey       = `EC PRIVATE KEY`
 	PEMBlockTypeRSAPrivateKey      = `RSA PRIVATE KEY`
 	PEMBlockTypePublicKey          = `PUBLIC KEY`
@@ -246,7 +247,7 @@ func TLSRSAX509SelfSignedCACertificatePEM(t *testing.T, keyPem string) string {
 		BasicConstraintsValid: true,
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
 		IsCA:                  true,
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
 		NotAfter:              time.Now().Add(24 * time.Hour), //nolint:gomnd
 		NotBefore:             time.Now(),
 		SerialNumber:          serialNumber,
@@ -271,6 +272,57 @@ func TLSRSAX509SelfSignedCACertificatePEM(t *testing.T, keyPem string) string {
 	return string(pem.EncodeToMemory(certificateBlock))
 }
 
+// TLSRSAX509CertificateRevocationListPEM generates a x509 certificate revocation list PEM string,
+// signed by the specified CA, that revokes a single random serial number.
+func TLSRSAX509CertificateRevocationListPEM(t *testing.T, caKeyPem, caCertificatePem string) string {
+	caCertificateBlock, _ := pem.Decode([]byte(caCertificatePem))
+
+	caCertificate, err := x509.ParseCertificate(caCertificateBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caKeyBlock, _ := pem.Decode([]byte(caKeyPem))
+
+	caKey, err := x509.ParsePKCS1PrivateKey(caKeyBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	revokedSerialNumber, err := rand.Int(rand.Reader, tlsX509CertificateSerialNumberLimit)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now(),
+		NextUpdate: time.Now().Add(24 * time.Hour), //nolint:gomnd
+		RevokedCertificates: []pkix.RevokedCertificate{
+			{
+				RevocationTime: time.Now(),
+				SerialNumber:   revokedSerialNumber,
+			},
+		},
+	}
+
+	crlBytes, err := x509.CreateRevocationList(rand.Reader, template, caCertificate, caKey)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crlBlock := &pem.Block{
+		Bytes: crlBytes,
+		Type:  PEMBlockTypeCRL,
+	}
+
+	return string(pem.EncodeToMemory(crlBlock))
+}
+
 // TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM generates a x509 CA certificate PEM string.
 // The CA certificate is suitable for use as an IAM RolesAnywhere Trust Anchor.
 // See https://docs.aws.amazon.com/rolesanywhere/latest/userguide/trust-model.html#signature-verification.
@@ -0,0 +1,1533 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ecsPropertiesSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"task_properties": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"containers": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"command": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"depends_on": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"condition": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"container_name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"environment": environmentSchema(),
+									"essential": {
+										Type:             nullable.TypeNullableBool,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+										ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+									},
+									"image": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"linux_parameters":  linuxParametersSchema(),
+									"log_configuration": logConfigurationSchema(),
+									"mount_points":      mountPointsSchema(),
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"privileged": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"readonly_root_filesystem": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"repository_credentials": repositoryCredentialsSchema(),
+									"resource_requirements":  resourceRequirementsSchema(),
+									"secrets":                secretsSchema(),
+									"ulimits":                ulimitsSchema(),
+									"user": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"ephemeral_storage": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"size_in_gib": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(21, 200),
+									},
+								},
+							},
+						},
+						"execution_role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"ipc_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"network_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"assign_public_ip": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(batch.AssignPublicIp_Values(), false),
+									},
+								},
+							},
+						},
+						"pid_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"platform_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"runtime_platform": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cpu_architecture": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"operating_system_family": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"task_role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"volumes": volumesSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func environmentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func linuxParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"devices": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"container_path": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"host_path": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"permissions": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringInSlice(batch.DeviceCgroupPermission_Values(), false),
+								},
+							},
+						},
+					},
+				},
+				"init_process_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"max_swap": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+				"shared_memory_size": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+				"swappiness": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"tmpfs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"container_path": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"mount_options": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"size": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func logConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"log_driver": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(batch.LogDriver_Values(), false),
+				},
+				"options": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"secret_options": secretsSchema(),
+			},
+		},
+	}
+}
+
+func mountPointsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"container_path": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"read_only": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"source_volume": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func repositoryCredentialsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"credentials_parameter": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceRequirementsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(batch.ResourceType_Values(), false),
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func secretsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"value_from": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func ulimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"hard_limit": {
+					Type:     schema.TypeInt,
+					Required: true,
+					ForceNew: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"soft_limit": {
+					Type:     schema.TypeInt,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func volumesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"efs_volume_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"authorization_config": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"access_point_id": {
+											Type:     schema.TypeString,
+											Optional: true,
+											ForceNew: true,
+										},
+										"iam": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(batch.EFSAuthorizationConfigIAM_Values(), false),
+										},
+									},
+								},
+							},
+							"file_system_id": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"root_directory": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"transit_encryption": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(batch.EFSTransitEncryption_Values(), false),
+							},
+							"transit_encryption_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+						},
+					},
+				},
+				"host": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"source_path": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func expandECSProperties(tfMap map[string]interface{}) *batch.EcsProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.EcsProperties{}
+
+	if v, ok := tfMap["task_properties"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TaskProperties = expandECSTaskProperties(v)
+	}
+
+	return apiObject
+}
+
+func expandECSTaskProperties(tfList []interface{}) []*batch.EcsTaskProperties {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.EcsTaskProperties
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.EcsTaskProperties{}
+
+		if v, ok := tfMap["containers"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Containers = expandECSTaskContainers(v)
+		}
+
+		if v, ok := tfMap["ephemeral_storage"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.EphemeralStorage = expandEphemeralStorage(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["execution_role_arn"].(string); ok && v != "" {
+			apiObject.ExecutionRoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["ipc_mode"].(string); ok && v != "" {
+			apiObject.IpcMode = aws.String(v)
+		}
+
+		if v, ok := tfMap["network_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.NetworkConfiguration = expandNetworkConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["pid_mode"].(string); ok && v != "" {
+			apiObject.PidMode = aws.String(v)
+		}
+
+		if v, ok := tfMap["platform_version"].(string); ok && v != "" {
+			apiObject.PlatformVersion = aws.String(v)
+		}
+
+		if v, ok := tfMap["runtime_platform"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.RuntimePlatform = expandRuntimePlatform(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["task_role_arn"].(string); ok && v != "" {
+			apiObject.TaskRoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["volumes"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Volumes = expandVolumes(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandECSTaskContainers(tfList []interface{}) []*batch.TaskContainerProperties {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.TaskContainerProperties
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.TaskContainerProperties{}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+			apiObject.DependsOn = expandTaskContainerDependencies(v)
+		}
+
+		if v, ok := tfMap["environment"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Environment = expandKeyValuePairs(v.List())
+		}
+
+		if v, null, _ := nullable.Bool(tfMap["essential"].(string)).Value(); !null {
+			apiObject.Essential = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["image"].(string); ok && v != "" {
+			apiObject.Image = aws.String(v)
+		}
+
+		if v, ok := tfMap["linux_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.LinuxParameters = expandLinuxParameters(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.LogConfiguration = expandLogConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["mount_points"].([]interface{}); ok && len(v) > 0 {
+			apiObject.MountPoints = expandMountPoints(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["privileged"].(bool); ok && v {
+			apiObject.Privileged = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+			apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["repository_credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.RepositoryCredentials = expandRepositoryCredentials(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["resource_requirements"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ResourceRequirements = expandResourceRequirements(v.List())
+		}
+
+		if v, ok := tfMap["secrets"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Secrets = expandSecrets(v)
+		}
+
+		if v, ok := tfMap["ulimits"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Ulimits = expandUlimits(v)
+		}
+
+		if v, ok := tfMap["user"].(string); ok && v != "" {
+			apiObject.User = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTaskContainerDependencies(tfList []interface{}) []*batch.TaskContainerDependency {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.TaskContainerDependency
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.TaskContainerDependency{}
+
+		if v, ok := tfMap["condition"].(string); ok && v != "" {
+			apiObject.Condition = aws.String(v)
+		}
+
+		if v, ok := tfMap["container_name"].(string); ok && v != "" {
+			apiObject.ContainerName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEphemeralStorage(tfMap map[string]interface{}) *batch.EphemeralStorage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.EphemeralStorage{}
+
+	if v, ok := tfMap["size_in_gib"].(int); ok && v != 0 {
+		apiObject.SizeInGiB = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandNetworkConfiguration(tfMap map[string]interface{}) *batch.NetworkConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.NetworkConfiguration{}
+
+	if v, ok := tfMap["assign_public_ip"].(string); ok && v != "" {
+		apiObject.AssignPublicIp = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRuntimePlatform(tfMap map[string]interface{}) *batch.RuntimePlatform {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.RuntimePlatform{}
+
+	if v, ok := tfMap["cpu_architecture"].(string); ok && v != "" {
+		apiObject.CpuArchitecture = aws.String(v)
+	}
+
+	if v, ok := tfMap["operating_system_family"].(string); ok && v != "" {
+		apiObject.OperatingSystemFamily = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandKeyValuePairs(tfList []interface{}) []*batch.KeyValuePair {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.KeyValuePair
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.KeyValuePair{}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLinuxParameters(tfMap map[string]interface{}) *batch.LinuxParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.LinuxParameters{}
+
+	if v, ok := tfMap["devices"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			device := &batch.Device{}
+
+			if v, ok := tfMap["container_path"].(string); ok && v != "" {
+				device.ContainerPath = aws.String(v)
+			}
+
+			if v, ok := tfMap["host_path"].(string); ok && v != "" {
+				device.HostPath = aws.String(v)
+			}
+
+			if v, ok := tfMap["permissions"].(*schema.Set); ok && v.Len() > 0 {
+				device.Permissions = flex.ExpandStringSet(v)
+			}
+
+			apiObject.Devices = append(apiObject.Devices, device)
+		}
+	}
+
+	if v, ok := tfMap["init_process_enabled"].(bool); ok && v {
+		apiObject.InitProcessEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["max_swap"].(int); ok && v != 0 {
+		apiObject.MaxSwap = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["shared_memory_size"].(int); ok && v != 0 {
+		apiObject.SharedMemorySize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["swappiness"].(int); ok && v != 0 {
+		apiObject.Swappiness = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["tmpfs"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			tmpfs := &batch.Tmpfs{}
+
+			if v, ok := tfMap["container_path"].(string); ok && v != "" {
+				tmpfs.ContainerPath = aws.String(v)
+			}
+
+			if v, ok := tfMap["mount_options"].(*schema.Set); ok && v.Len() > 0 {
+				tmpfs.MountOptions = flex.ExpandStringSet(v)
+			}
+
+			if v, ok := tfMap["size"].(int); ok && v != 0 {
+				tmpfs.Size = aws.Int64(int64(v))
+			}
+
+			apiObject.Tmpfs = append(apiObject.Tmpfs, tmpfs)
+		}
+	}
+
+	return apiObject
+}
+
+func expandLogConfiguration(tfMap map[string]interface{}) *batch.LogConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.LogConfiguration{}
+
+	if v, ok := tfMap["log_driver"].(string); ok && v != "" {
+		apiObject.LogDriver = aws.String(v)
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["secret_options"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SecretOptions = expandSecrets(v)
+	}
+
+	return apiObject
+}
+
+func expandMountPoints(tfList []interface{}) []*batch.MountPoint {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.MountPoint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.MountPoint{}
+
+		if v, ok := tfMap["container_path"].(string); ok && v != "" {
+			apiObject.ContainerPath = aws.String(v)
+		}
+
+		if v, ok := tfMap["read_only"].(bool); ok {
+			apiObject.ReadOnly = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["source_volume"].(string); ok && v != "" {
+			apiObject.SourceVolume = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandRepositoryCredentials(tfMap map[string]interface{}) *batch.RepositoryCredentials {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.RepositoryCredentials{}
+
+	if v, ok := tfMap["credentials_parameter"].(string); ok && v != "" {
+		apiObject.CredentialsParameter = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandResourceRequirements(tfList []interface{}) []*batch.ResourceRequirement {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.ResourceRequirement
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.ResourceRequirement{}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSecrets(tfList []interface{}) []*batch.Secret {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.Secret
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.Secret{}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["value_from"].(string); ok && v != "" {
+			apiObject.ValueFrom = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandUlimits(tfList []interface{}) []*batch.Ulimit {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.Ulimit
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.Ulimit{}
+
+		if v, ok := tfMap["hard_limit"].(int); ok {
+			apiObject.HardLimit = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["soft_limit"].(int); ok {
+			apiObject.SoftLimit = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandVolumes(tfList []interface{}) []*batch.Volume {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.Volume
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.Volume{}
+
+		if v, ok := tfMap["efs_volume_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.EfsVolumeConfiguration = &batch.EFSVolumeConfiguration{}
+
+			if v, ok := tfMap["authorization_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				apiObject.EfsVolumeConfiguration.AuthorizationConfig = &batch.EFSAuthorizationConfig{}
+
+				if v, ok := tfMap["access_point_id"].(string); ok && v != "" {
+					apiObject.EfsVolumeConfiguration.AuthorizationConfig.AccessPointId = aws.String(v)
+				}
+
+				if v, ok := tfMap["iam"].(string); ok && v != "" {
+					apiObject.EfsVolumeConfiguration.AuthorizationConfig.Iam = aws.String(v)
+				}
+			}
+
+			if v, ok := tfMap["file_system_id"].(string); ok && v != "" {
+				apiObject.EfsVolumeConfiguration.FileSystemId = aws.String(v)
+			}
+
+			if v, ok := tfMap["root_directory"].(string); ok && v != "" {
+				apiObject.EfsVolumeConfiguration.RootDirectory = aws.String(v)
+			}
+
+			if v, ok := tfMap["transit_encryption"].(string); ok && v != "" {
+				apiObject.EfsVolumeConfiguration.TransitEncryption = aws.String(v)
+			}
+
+			if v, ok := tfMap["transit_encryption_port"].(int); ok && v != 0 {
+				apiObject.EfsVolumeConfiguration.TransitEncryptionPort = aws.Int64(int64(v))
+			}
+		}
+
+		if v, ok := tfMap["host"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Host = &batch.Host{}
+
+			if v, ok := tfMap["source_path"].(string); ok && v != "" {
+				apiObject.Host.SourcePath = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenECSProperties(apiObject *batch.EcsProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TaskProperties; v != nil {
+		tfMap["task_properties"] = flattenECSTaskProperties(v)
+	}
+
+	return tfMap
+}
+
+func flattenECSTaskProperties(apiObjects []*batch.EcsTaskProperties) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Containers; v != nil {
+			tfMap["containers"] = flattenECSTaskContainers(v)
+		}
+
+		if v := apiObject.EphemeralStorage; v != nil {
+			tfMap["ephemeral_storage"] = []interface{}{map[string]interface{}{
+				"size_in_gib": aws.Int64Value(v.SizeInGiB),
+			}}
+		}
+
+		if v := apiObject.ExecutionRoleArn; v != nil {
+			tfMap["execution_role_arn"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.IpcMode; v != nil {
+			tfMap["ipc_mode"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.NetworkConfiguration; v != nil {
+			tfMap["network_configuration"] = []interface{}{map[string]interface{}{
+				"assign_public_ip": aws.StringValue(v.AssignPublicIp),
+			}}
+		}
+
+		if v := apiObject.PidMode; v != nil {
+			tfMap["pid_mode"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.PlatformVersion; v != nil {
+			tfMap["platform_version"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.RuntimePlatform; v != nil {
+			tfMap["runtime_platform"] = []interface{}{map[string]interface{}{
+				"cpu_architecture":        aws.StringValue(v.CpuArchitecture),
+				"operating_system_family": aws.StringValue(v.OperatingSystemFamily),
+			}}
+		}
+
+		if v := apiObject.TaskRoleArn; v != nil {
+			tfMap["task_role_arn"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Volumes; v != nil {
+			tfMap["volumes"] = flattenVolumes(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenECSTaskContainers(apiObjects []*batch.TaskContainerProperties) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Command; v != nil {
+			tfMap["command"] = aws.StringValueSlice(v)
+		}
+
+		if v := apiObject.DependsOn; v != nil {
+			var tfList []interface{}
+
+			for _, apiObject := range v {
+				if apiObject == nil {
+					continue
+				}
+
+				tfList = append(tfList, map[string]interface{}{
+					"condition":      aws.StringValue(apiObject.Condition),
+					"container_name": aws.StringValue(apiObject.ContainerName),
+				})
+			}
+
+			tfMap["depends_on"] = tfList
+		}
+
+		if v := apiObject.Environment; v != nil {
+			tfMap["environment"] = flattenKeyValuePairs(v)
+		}
+
+		if v := apiObject.Essential; v != nil {
+			tfMap["essential"] = strconv.FormatBool(aws.BoolValue(v))
+		}
+
+		if v := apiObject.Image; v != nil {
+			tfMap["image"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.LinuxParameters; v != nil {
+			tfMap["linux_parameters"] = []interface{}{flattenLinuxParameters(v)}
+		}
+
+		if v := apiObject.LogConfiguration; v != nil {
+			tfMap["log_configuration"] = []interface{}{flattenLogConfiguration(v)}
+		}
+
+		if v := apiObject.MountPoints; v != nil {
+			tfMap["mount_points"] = flattenMountPoints(v)
+		}
+
+		if v := apiObject.Name; v != nil {
+			tfMap["name"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Privileged; v != nil {
+			tfMap["privileged"] = aws.BoolValue(v)
+		}
+
+		if v := apiObject.ReadonlyRootFilesystem; v != nil {
+			tfMap["readonly_root_filesystem"] = aws.BoolValue(v)
+		}
+
+		if v := apiObject.RepositoryCredentials; v != nil {
+			tfMap["repository_credentials"] = []interface{}{map[string]interface{}{
+				"credentials_parameter": aws.StringValue(v.CredentialsParameter),
+			}}
+		}
+
+		if v := apiObject.ResourceRequirements; v != nil {
+			tfMap["resource_requirements"] = flattenResourceRequirements(v)
+		}
+
+		if v := apiObject.Secrets; v != nil {
+			tfMap["secrets"] = flattenSecrets(v)
+		}
+
+		if v := apiObject.Ulimits; v != nil {
+			tfMap["ulimits"] = flattenUlimits(v)
+		}
+
+		if v := apiObject.User; v != nil {
+			tfMap["user"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenKeyValuePairs(apiObjects []*batch.KeyValuePair) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":  aws.StringValue(apiObject.Name),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenLinuxParameters(apiObject *batch.LinuxParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Devices; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"container_path": aws.StringValue(apiObject.ContainerPath),
+				"host_path":      aws.StringValue(apiObject.HostPath),
+				"permissions":    aws.StringValueSlice(apiObject.Permissions),
+			})
+		}
+
+		tfMap["devices"] = tfList
+	}
+
+	if v := apiObject.InitProcessEnabled; v != nil {
+		tfMap["init_process_enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.MaxSwap; v != nil {
+		tfMap["max_swap"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.SharedMemorySize; v != nil {
+		tfMap["shared_memory_size"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Swappiness; v != nil {
+		tfMap["swappiness"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Tmpfs; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"container_path": aws.StringValue(apiObject.ContainerPath),
+				"mount_options":  aws.StringValueSlice(apiObject.MountOptions),
+				"size":           aws.Int64Value(apiObject.Size),
+			})
+		}
+
+		tfMap["tmpfs"] = tfList
+	}
+
+	return tfMap
+}
+
+func flattenLogConfiguration(apiObject *batch.LogConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LogDriver; v != nil {
+		tfMap["log_driver"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Options; len(v) > 0 {
+		tfMap["options"] = aws.StringValueMap(v)
+	}
+
+	if v := apiObject.SecretOptions; len(v) > 0 {
+		tfMap["secret_options"] = flattenSecrets(v)
+	}
+
+	return tfMap
+}
+
+func flattenMountPoints(apiObjects []*batch.MountPoint) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"container_path": aws.StringValue(apiObject.ContainerPath),
+			"read_only":      aws.BoolValue(apiObject.ReadOnly),
+			"source_volume":  aws.StringValue(apiObject.SourceVolume),
+		})
+	}
+
+	return tfList
+}
+
+func flattenResourceRequirements(apiObjects []*batch.ResourceRequirement) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"type":  aws.StringValue(apiObject.Type),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenSecrets(apiObjects []*batch.Secret) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":       aws.StringValue(apiObject.Name),
+			"value_from": aws.StringValue(apiObject.ValueFrom),
+		})
+	}
+
+	return tfList
+}
+
+func flattenUlimits(apiObjects []*batch.Ulimit) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"hard_limit": aws.Int64Value(apiObject.HardLimit),
+			"name":       aws.StringValue(apiObject.Name),
+			"soft_limit": aws.Int64Value(apiObject.SoftLimit),
+		})
+	}
+
+	return tfList
+}
+
+func flattenVolumes(apiObjects []*batch.Volume) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.EfsVolumeConfiguration; v != nil {
+			efsMap := map[string]interface{}{
+				"file_system_id":          aws.StringValue(v.FileSystemId),
+				"root_directory":          aws.StringValue(v.RootDirectory),
+				"transit_encryption":      aws.StringValue(v.TransitEncryption),
+				"transit_encryption_port": aws.Int64Value(v.TransitEncryptionPort),
+			}
+
+			if v := v.AuthorizationConfig; v != nil {
+				efsMap["authorization_config"] = []interface{}{map[string]interface{}{
+					"access_point_id": aws.StringValue(v.AccessPointId),
+					"iam":             aws.StringValue(v.Iam),
+				}}
+			}
+
+			tfMap["efs_volume_configuration"] = []interface{}{efsMap}
+		}
+
+		if v := apiObject.Host; v != nil {
+			tfMap["host"] = []interface{}{map[string]interface{}{
+				"source_path": aws.StringValue(v.SourcePath),
+			}}
+		}
+
+		if v := apiObject.Name; v != nil {
+			tfMap["name"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
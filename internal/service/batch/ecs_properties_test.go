@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfbatch "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+)
+
+func TestExpandFlattenECSProperties(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		apiObject *batch.EcsProperties
+	}{
+		{
+			apiObject: &batch.EcsProperties{
+				TaskProperties: []*batch.EcsTaskProperties{
+					{
+						Containers: []*batch.TaskContainerProperties{
+							{
+								Essential: aws.Bool(true),
+								Image:     aws.String("public.ecr.aws/amazonlinux/amazonlinux:1"),
+								Name:      aws.String("container_a"),
+							},
+							{
+								Essential: aws.Bool(false),
+								Image:     aws.String("public.ecr.aws/amazonlinux/amazonlinux:1"),
+								Name:      aws.String("container_b"),
+							},
+							{
+								Image: aws.String("public.ecr.aws/amazonlinux/amazonlinux:1"),
+								Name:  aws.String("container_c"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			apiObject: &batch.EcsProperties{
+				TaskProperties: []*batch.EcsTaskProperties{
+					{
+						Containers: []*batch.TaskContainerProperties{
+							{
+								Command: aws.StringSlice([]string{"echo", "test"}),
+								DependsOn: []*batch.TaskContainerDependency{
+									{
+										Condition:     aws.String("COMPLETE"),
+										ContainerName: aws.String("container_b"),
+									},
+								},
+								Environment: []*batch.KeyValuePair{
+									{
+										Name:  aws.String("TEST"),
+										Value: aws.String("Environment Variable"),
+									},
+								},
+								Essential:  aws.Bool(true),
+								Image:      aws.String("public.ecr.aws/amazonlinux/amazonlinux:1"),
+								Name:       aws.String("container_a"),
+								Privileged: aws.Bool(true),
+								ResourceRequirements: []*batch.ResourceRequirement{
+									{
+										Type:  aws.String(batch.ResourceTypeVcpu),
+										Value: aws.String("1"),
+									},
+									{
+										Type:  aws.String(batch.ResourceTypeMemory),
+										Value: aws.String("2048"),
+									},
+								},
+							},
+						},
+						ExecutionRoleArn: aws.String("arn:aws:iam::123456789012:role/execution"),
+						NetworkConfiguration: &batch.NetworkConfiguration{
+							AssignPublicIp: aws.String(batch.AssignPublicIpEnabled),
+						},
+						PlatformVersion: aws.String("LATEST"),
+						RuntimePlatform: &batch.RuntimePlatform{
+							CpuArchitecture:       aws.String("X86_64"),
+							OperatingSystemFamily: aws.String("LINUX"),
+						},
+						TaskRoleArn: aws.String("arn:aws:iam::123456789012:role/task"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		d := schema.TestResourceDataRaw(t, tfbatch.ResourceJobDefinition().Schema, map[string]interface{}{})
+
+		if err := d.Set("ecs_properties", []interface{}{tfbatch.FlattenECSProperties(testCase.apiObject)}); err != nil {
+			t.Fatalf("setting ecs_properties: %s", err)
+		}
+
+		expanded := tfbatch.ExpandECSProperties(d.Get("ecs_properties").([]interface{})[0].(map[string]interface{}))
+		if diff := cmp.Diff(expanded, testCase.apiObject); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	}
+}
@@ -0,0 +1,522 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	dnsPolicyDefault                 = "Default"
+	dnsPolicyClusterFirst            = "ClusterFirst"
+	dnsPolicyClusterFirstWithHostNet = "ClusterFirstWithHostNet"
+)
+
+func dnsPolicy_Values() []string {
+	return []string{
+		dnsPolicyDefault,
+		dnsPolicyClusterFirst,
+		dnsPolicyClusterFirstWithHostNet,
+	}
+}
+
+const (
+	imagePullPolicyAlways       = "Always"
+	imagePullPolicyIfNotPresent = "IfNotPresent"
+	imagePullPolicyNever        = "Never"
+)
+
+func imagePullPolicy_Values() []string {
+	return []string{
+		imagePullPolicyAlways,
+		imagePullPolicyIfNotPresent,
+		imagePullPolicyNever,
+	}
+}
+
+func expandEKSProperties(tfMap map[string]interface{}) *batch.EksProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.EksProperties{}
+
+	if v, ok := tfMap["pod_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PodProperties = expandEKSPodProperties(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandEKSPodProperties(tfMap map[string]interface{}) *batch.EksPodProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.EksPodProperties{}
+
+	if v, ok := tfMap["containers"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Containers = expandEKSContainers(v)
+	}
+
+	if v, ok := tfMap["dns_policy"].(string); ok && v != "" {
+		apiObject.DnsPolicy = aws.String(v)
+	}
+
+	if v, ok := tfMap["host_network"].(bool); ok {
+		apiObject.HostNetwork = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["metadata"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["labels"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Metadata = &batch.EksMetadata{
+				Labels: flex.ExpandStringMap(v),
+			}
+		}
+	}
+
+	if v, ok := tfMap["service_account_name"].(string); ok && v != "" {
+		apiObject.ServiceAccountName = aws.String(v)
+	}
+
+	if v, ok := tfMap["volumes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Volumes = expandEKSVolumes(v)
+	}
+
+	return apiObject
+}
+
+func expandEKSContainers(tfList []interface{}) []*batch.EksContainer {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.EksContainer
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.EksContainer{}
+
+		if v, ok := tfMap["args"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Args = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["env"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Env = expandEKSContainerEnvironmentVariables(v.List())
+		}
+
+		if v, ok := tfMap["image"].(string); ok && v != "" {
+			apiObject.Image = aws.String(v)
+		}
+
+		if v, ok := tfMap["image_pull_policy"].(string); ok && v != "" {
+			apiObject.ImagePullPolicy = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["resources"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Resources = expandEKSContainerResourceRequirements(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["security_context"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SecurityContext = expandEKSContainerSecurityContext(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["volume_mounts"].([]interface{}); ok && len(v) > 0 {
+			apiObject.VolumeMounts = expandEKSContainerVolumeMounts(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEKSContainerEnvironmentVariables(tfList []interface{}) []*batch.EksContainerEnvironmentVariable {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.EksContainerEnvironmentVariable
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.EksContainerEnvironmentVariable{}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEKSContainerResourceRequirements(tfMap map[string]interface{}) *batch.EksContainerResourceRequirements {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.EksContainerResourceRequirements{}
+
+	if v, ok := tfMap["limits"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Limits = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["requests"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Requests = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func expandEKSContainerSecurityContext(tfMap map[string]interface{}) *batch.EksContainerSecurityContext {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.EksContainerSecurityContext{}
+
+	if v, ok := tfMap["privileged"].(bool); ok {
+		apiObject.Privileged = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["read_only_root_file_system"].(bool); ok {
+		apiObject.ReadOnlyRootFilesystem = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["run_as_group"].(int); ok && v != 0 {
+		apiObject.RunAsGroup = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["run_as_non_root"].(bool); ok {
+		apiObject.RunAsNonRoot = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["run_as_user"].(int); ok && v != 0 {
+		apiObject.RunAsUser = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandEKSContainerVolumeMounts(tfList []interface{}) []*batch.EksContainerVolumeMount {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.EksContainerVolumeMount
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.EksContainerVolumeMount{}
+
+		if v, ok := tfMap["mount_path"].(string); ok && v != "" {
+			apiObject.MountPath = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["read_only"].(bool); ok {
+			apiObject.ReadOnly = aws.Bool(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEKSVolumes(tfList []interface{}) []*batch.EksVolume {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.EksVolume
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.EksVolume{}
+
+		if v, ok := tfMap["empty_dir"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.EmptyDir = &batch.EksEmptyDir{}
+
+			if v, ok := tfMap["medium"].(string); ok && v != "" {
+				apiObject.EmptyDir.Medium = aws.String(v)
+			}
+
+			if v, ok := tfMap["size_limit"].(string); ok && v != "" {
+				apiObject.EmptyDir.SizeLimit = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["host_path"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.HostPath = &batch.EksHostPath{}
+
+			if v, ok := tfMap["path"].(string); ok && v != "" {
+				apiObject.HostPath.Path = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["secret"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Secret = &batch.EksSecret{}
+
+			if v, ok := tfMap["optional"].(bool); ok {
+				apiObject.Secret.Optional = aws.Bool(v)
+			}
+
+			if v, ok := tfMap["secret_name"].(string); ok && v != "" {
+				apiObject.Secret.SecretName = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEKSProperties(apiObject *batch.EksProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PodProperties; v != nil {
+		tfMap["pod_properties"] = []interface{}{flattenEKSPodProperties(v)}
+	}
+
+	return tfMap
+}
+
+func flattenEKSPodProperties(apiObject *batch.EksPodProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Containers; v != nil {
+		tfMap["containers"] = flattenEKSContainers(v)
+	}
+
+	if v := apiObject.DnsPolicy; v != nil {
+		tfMap["dns_policy"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.HostNetwork; v != nil {
+		tfMap["host_network"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Metadata; v != nil && len(v.Labels) > 0 {
+		tfMap["metadata"] = []interface{}{map[string]interface{}{
+			"labels": aws.StringValueMap(v.Labels),
+		}}
+	}
+
+	if v := apiObject.ServiceAccountName; v != nil {
+		tfMap["service_account_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Volumes; v != nil {
+		tfMap["volumes"] = flattenEKSVolumes(v)
+	}
+
+	return tfMap
+}
+
+func flattenEKSContainers(apiObjects []*batch.EksContainer) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Args; v != nil {
+			tfMap["args"] = aws.StringValueSlice(v)
+		}
+
+		if v := apiObject.Command; v != nil {
+			tfMap["command"] = aws.StringValueSlice(v)
+		}
+
+		if v := apiObject.Env; v != nil {
+			tfMap["env"] = flattenEKSContainerEnvironmentVariables(v)
+		}
+
+		if v := apiObject.Image; v != nil {
+			tfMap["image"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.ImagePullPolicy; v != nil {
+			tfMap["image_pull_policy"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Name; v != nil {
+			tfMap["name"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Resources; v != nil {
+			tfMap["resources"] = []interface{}{map[string]interface{}{
+				"limits":   aws.StringValueMap(v.Limits),
+				"requests": aws.StringValueMap(v.Requests),
+			}}
+		}
+
+		if v := apiObject.SecurityContext; v != nil {
+			tfMap["security_context"] = []interface{}{map[string]interface{}{
+				"privileged":                 aws.BoolValue(v.Privileged),
+				"read_only_root_file_system": aws.BoolValue(v.ReadOnlyRootFilesystem),
+				"run_as_group":               aws.Int64Value(v.RunAsGroup),
+				"run_as_non_root":            aws.BoolValue(v.RunAsNonRoot),
+				"run_as_user":                aws.Int64Value(v.RunAsUser),
+			}}
+		}
+
+		if v := apiObject.VolumeMounts; v != nil {
+			tfMap["volume_mounts"] = flattenEKSContainerVolumeMounts(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEKSContainerEnvironmentVariables(apiObjects []*batch.EksContainerEnvironmentVariable) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":  aws.StringValue(apiObject.Name),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenEKSContainerVolumeMounts(apiObjects []*batch.EksContainerVolumeMount) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"mount_path": aws.StringValue(apiObject.MountPath),
+			"name":       aws.StringValue(apiObject.Name),
+			"read_only":  aws.BoolValue(apiObject.ReadOnly),
+		})
+	}
+
+	return tfList
+}
+
+func flattenEKSVolumes(apiObjects []*batch.EksVolume) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.EmptyDir; v != nil {
+			tfMap["empty_dir"] = []interface{}{map[string]interface{}{
+				"medium":     aws.StringValue(v.Medium),
+				"size_limit": aws.StringValue(v.SizeLimit),
+			}}
+		}
+
+		if v := apiObject.HostPath; v != nil {
+			tfMap["host_path"] = []interface{}{map[string]interface{}{
+				"path": aws.StringValue(v.Path),
+			}}
+		}
+
+		if v := apiObject.Secret; v != nil {
+			tfMap["secret"] = []interface{}{map[string]interface{}{
+				"optional":    aws.BoolValue(v.Optional),
+				"secret_name": aws.StringValue(v.SecretName),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfbatch "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+)
+
+func TestExpandFlattenEKSProperties(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		apiObject *batch.EksProperties
+	}{
+		{
+			apiObject: &batch.EksProperties{
+				PodProperties: &batch.EksPodProperties{
+					Containers: []*batch.EksContainer{
+						{
+							Image: aws.String("public.ecr.aws/amazonlinux/amazonlinux:1"),
+						},
+					},
+					HostNetwork: aws.Bool(true),
+				},
+			},
+		},
+		{
+			apiObject: &batch.EksProperties{
+				PodProperties: &batch.EksPodProperties{
+					Containers: []*batch.EksContainer{
+						{
+							Args:    aws.StringSlice([]string{"60"}),
+							Command: aws.StringSlice([]string{"sleep"}),
+							Env: []*batch.EksContainerEnvironmentVariable{
+								{
+									Name:  aws.String("TEST"),
+									Value: aws.String("Environment Variable"),
+								},
+							},
+							Image:           aws.String("public.ecr.aws/amazonlinux/amazonlinux:1"),
+							ImagePullPolicy: aws.String("Always"),
+							Name:            aws.String("test"),
+							Resources: &batch.EksContainerResourceRequirements{
+								Limits: aws.StringMap(map[string]string{
+									"cpu":    "1",
+									"memory": "1024Mi",
+								}),
+							},
+							SecurityContext: &batch.EksContainerSecurityContext{
+								Privileged:             aws.Bool(true),
+								ReadOnlyRootFilesystem: aws.Bool(false),
+								RunAsGroup:             aws.Int64(1000),
+								RunAsNonRoot:           aws.Bool(true),
+								RunAsUser:              aws.Int64(1000),
+							},
+							VolumeMounts: []*batch.EksContainerVolumeMount{
+								{
+									MountPath: aws.String("/tmp"),
+									Name:      aws.String("tmp"),
+									ReadOnly:  aws.Bool(true),
+								},
+							},
+						},
+					},
+					DnsPolicy:   aws.String("ClusterFirst"),
+					HostNetwork: aws.Bool(false),
+					Metadata: &batch.EksMetadata{
+						Labels: aws.StringMap(map[string]string{
+							"environment": "test",
+						}),
+					},
+					ServiceAccountName: aws.String("test"),
+					Volumes: []*batch.EksVolume{
+						{
+							EmptyDir: &batch.EksEmptyDir{
+								Medium:    aws.String("Memory"),
+								SizeLimit: aws.String("1Gi"),
+							},
+							Name: aws.String("tmp"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		d := schema.TestResourceDataRaw(t, tfbatch.ResourceJobDefinition().Schema, map[string]interface{}{})
+
+		if err := d.Set("eks_properties", []interface{}{tfbatch.FlattenEKSProperties(testCase.apiObject)}); err != nil {
+			t.Fatalf("setting eks_properties: %s", err)
+		}
+
+		expanded := tfbatch.ExpandEKSProperties(d.Get("eks_properties").([]interface{})[0].(map[string]interface{}))
+		if diff := cmp.Diff(expanded, testCase.apiObject); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	}
+}
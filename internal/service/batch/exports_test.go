@@ -6,6 +6,12 @@ package batch
 // Exports for use in tests only.
 var (
 	ExpandEC2ConfigurationsUpdate           = expandEC2ConfigurationsUpdate
+	ExpandECSProperties                     = expandECSProperties
+	ExpandEKSProperties                     = expandEKSProperties
 	ExpandLaunchTemplateSpecificationUpdate = expandLaunchTemplateSpecificationUpdate
+	ExpandNodeProperties                    = expandNodeProperties
 	FindComputeEnvironmentDetailByName      = findComputeEnvironmentDetailByName
+	FlattenECSProperties                    = flattenECSProperties
+	FlattenEKSProperties                    = flattenEKSProperties
+	FlattenNodeProperties                   = flattenNodeProperties
 )
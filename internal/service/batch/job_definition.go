@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/batch"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -48,26 +49,313 @@ func ResourceJobDefinition() *schema.Resource {
 				Computed: true,
 			},
 			"container_properties": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"ecs_properties", "eks_properties", "node_properties"},
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
 				},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if verify.SuppressEquivalentJSONDiffs(k, old, new, d) {
+						return true
+					}
+
 					equal, _ := EquivalentContainerPropertiesJSON(old, new)
 
 					return equal
 				},
 				ValidateFunc: validJobContainerProperties,
 			},
+			"ecs_properties": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"container_properties", "eks_properties", "node_properties"},
+				Elem:          ecsPropertiesSchema(),
+			},
+			"eks_properties": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"container_properties", "ecs_properties", "node_properties"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pod_properties": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"containers": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										MaxItems: 10,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"args": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"command": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"env": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													ForceNew: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"name": {
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+															"value": {
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+												"image": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"image_pull_policy": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(imagePullPolicy_Values(), false),
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"resources": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"limits": {
+																Type:     schema.TypeMap,
+																Optional: true,
+																ForceNew: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+															"requests": {
+																Type:     schema.TypeMap,
+																Optional: true,
+																ForceNew: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+														},
+													},
+												},
+												"security_context": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"privileged": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																ForceNew: true,
+															},
+															"read_only_root_file_system": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																ForceNew: true,
+															},
+															"run_as_group": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																ForceNew: true,
+															},
+															"run_as_non_root": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																ForceNew: true,
+															},
+															"run_as_user": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+												"volume_mounts": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"mount_path": {
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+															"name": {
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+															"read_only": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"dns_policy": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(dnsPolicy_Values(), false),
+									},
+									"host_network": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"metadata": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"labels": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"service_account_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"volumes": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"empty_dir": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"medium": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ForceNew:     true,
+																Default:      "",
+																ValidateFunc: validation.StringInSlice([]string{"", "Memory"}, true),
+															},
+															"size_limit": {
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+												"host_path": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"path": {
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+													Default:  "Default",
+												},
+												"secret": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"optional": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																ForceNew: true,
+															},
+															"secret_name": {
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validName,
 			},
+			"node_properties": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"container_properties", "ecs_properties", "eks_properties"},
+				Elem:          nodePropertiesSchema(),
+			},
 			"parameters": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -178,14 +466,32 @@ func ResourceJobDefinition() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{batch.JobDefinitionTypeContainer}, true),
+				ValidateFunc: validation.StringInSlice(batch.JobDefinitionType_Values(), true),
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			jobDefinitionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func jobDefinitionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	switch t := d.Get("type").(string); {
+	case strings.EqualFold(t, batch.JobDefinitionTypeMultinode):
+		if v, ok := d.GetOk("node_properties"); !ok || len(v.([]interface{})) == 0 {
+			return fmt.Errorf("node_properties must be set when type is %q", batch.JobDefinitionTypeMultinode)
+		}
+	case strings.EqualFold(t, batch.JobDefinitionTypeContainer):
+		if v, ok := d.GetOk("node_properties"); ok && len(v.([]interface{})) > 0 {
+			return fmt.Errorf("node_properties cannot be set when type is %q", batch.JobDefinitionTypeContainer)
+		}
+	}
+
+	return nil
+}
+
 func resourceJobDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).BatchConn(ctx)
@@ -217,6 +523,18 @@ func resourceJobDefinitionCreate(ctx context.Context, d *schema.ResourceData, me
 		input.ContainerProperties = props
 	}
 
+	if v, ok := d.GetOk("ecs_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EcsProperties = expandECSProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("eks_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EksProperties = expandEKSProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("node_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.NodeProperties = expandNodeProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		input.Parameters = expandJobDefinitionParameters(v.(map[string]interface{}))
 	}
@@ -262,17 +580,46 @@ func resourceJobDefinitionRead(ctx context.Context, d *schema.ResourceData, meta
 
 	d.Set("arn", jobDefinition.JobDefinitionArn)
 
-	containerProperties, err := flattenContainerProperties(jobDefinition.ContainerProperties)
+	if jobDefinition.ContainerProperties != nil {
+		containerProperties, err := flattenContainerProperties(jobDefinition.ContainerProperties)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "converting Batch Container Properties to JSON: %s", err)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "converting Batch Container Properties to JSON: %s", err)
+		}
+
+		if err := d.Set("container_properties", containerProperties); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting container_properties: %s", err)
+		}
+	} else {
+		d.Set("container_properties", nil)
+	}
+
+	if jobDefinition.EcsProperties != nil {
+		if err := d.Set("ecs_properties", []interface{}{flattenECSProperties(jobDefinition.EcsProperties)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting ecs_properties: %s", err)
+		}
+	} else {
+		d.Set("ecs_properties", nil)
 	}
 
-	if err := d.Set("container_properties", containerProperties); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting container_properties: %s", err)
+	if jobDefinition.EksProperties != nil {
+		if err := d.Set("eks_properties", []interface{}{flattenEKSProperties(jobDefinition.EksProperties)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting eks_properties: %s", err)
+		}
+	} else {
+		d.Set("eks_properties", nil)
 	}
 
 	d.Set("name", jobDefinition.JobDefinitionName)
+
+	if jobDefinition.NodeProperties != nil {
+		if err := d.Set("node_properties", []interface{}{flattenNodeProperties(jobDefinition.NodeProperties)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting node_properties: %s", err)
+		}
+	} else {
+		d.Set("node_properties", nil)
+	}
+
 	d.Set("parameters", aws.StringValueMap(jobDefinition.Parameters))
 	d.Set("platform_capabilities", aws.StringValueSlice(jobDefinition.PlatformCapabilities))
 	d.Set("propagate_tags", jobDefinition.PropagateTags)
@@ -458,6 +458,142 @@ func TestAccBatchJobDefinition_ContainerProperties_EmptyField(t *testing.T) {
 	})
 }
 
+func TestAccBatchJobDefinition_ECSProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jd batch.JobDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, batch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobDefinitionConfig_ecsProperties(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobDefinitionExists(ctx, resourceName, &jd),
+					resource.TestCheckResourceAttr(resourceName, "container_properties", ""),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.0.containers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.0.containers.0.name", "container_a"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.0.containers.0.depends_on.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.0.containers.0.environment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.0.containers.1.name", "container_b"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "node_properties.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "container"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBatchJobDefinition_EKSProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jd batch.JobDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, batch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobDefinitionConfig_eksProperties(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobDefinitionExists(ctx, resourceName, &jd),
+					resource.TestCheckResourceAttr(resourceName, "container_properties", ""),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.image", "public.ecr.aws/amazonlinux/amazonlinux:1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.image_pull_policy", "Always"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.env.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.volume_mounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.host_network", "true"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.metadata.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.metadata.0.labels.environment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.volumes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.volumes.0.empty_dir.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.volumes.0.empty_dir.0.size_limit", "1Gi"),
+					resource.TestCheckResourceAttr(resourceName, "type", "container"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBatchJobDefinition_NodeProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jd batch.JobDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, batch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobDefinitionConfig_nodeProperties(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobDefinitionExists(ctx, resourceName, &jd),
+					resource.TestCheckResourceAttr(resourceName, "container_properties", ""),
+					resource.TestCheckResourceAttr(resourceName, "node_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "node_properties.0.main_node", "0"),
+					resource.TestCheckResourceAttr(resourceName, "node_properties.0.num_nodes", "2"),
+					resource.TestCheckResourceAttr(resourceName, "node_properties.0.node_range_properties.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "node_properties.0.node_range_properties.0.target_nodes", "0:"),
+					resource.TestCheckResourceAttr(resourceName, "node_properties.0.node_range_properties.0.container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "node_properties.0.node_range_properties.1.target_nodes", "1:"),
+					resource.TestCheckResourceAttr(resourceName, "node_properties.0.node_range_properties.1.container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "type", "multinode"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBatchJobDefinition_NodeProperties_typeValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, batch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobDefinitionConfig_multinodeWithoutNodeProperties(rName),
+				ExpectError: regexp.MustCompile(`node_properties must be set when type is "multinode"`),
+			},
+		},
+	})
+}
+
 func testAccCheckJobDefinitionExists(ctx context.Context, n string, jd *batch.JobDefinition) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -865,3 +1001,179 @@ resource "aws_batch_job_definition" "test" {
 }
 `, rName)
 }
+
+func testAccJobDefinitionConfig_ecsProperties(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  ecs_properties {
+    task_properties {
+      containers {
+        image   = "public.ecr.aws/amazonlinux/amazonlinux:1"
+        command = ["sleep", "60"]
+        name    = "container_a"
+
+        depends_on {
+          container_name = "container_b"
+          condition      = "COMPLETE"
+        }
+
+        environment {
+          name  = "ENVIRONMENT"
+          value = "test"
+        }
+
+        resource_requirements {
+          type  = "VCPU"
+          value = "1"
+        }
+
+        resource_requirements {
+          type  = "MEMORY"
+          value = "2048"
+        }
+      }
+
+      containers {
+        image     = "public.ecr.aws/amazonlinux/amazonlinux:1"
+        command   = ["sleep", "60"]
+        essential = false
+        name      = "container_b"
+
+        resource_requirements {
+          type  = "VCPU"
+          value = "1"
+        }
+
+        resource_requirements {
+          type  = "MEMORY"
+          value = "2048"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccJobDefinitionConfig_eksProperties(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  eks_properties {
+    pod_properties {
+      host_network = true
+
+      containers {
+        image             = "public.ecr.aws/amazonlinux/amazonlinux:1"
+        image_pull_policy = "Always"
+        command           = ["sleep", "60"]
+
+        env {
+          name  = "ENVIRONMENT"
+          value = "test"
+        }
+
+        resources {
+          limits = {
+            cpu    = "1"
+            memory = "1024Mi"
+          }
+        }
+
+        volume_mounts {
+          mount_path = "/tmp/cache"
+          name       = "cache"
+        }
+      }
+
+      metadata {
+        labels = {
+          environment = "test"
+        }
+      }
+
+      volumes {
+        name = "cache"
+
+        empty_dir {
+          medium     = "Memory"
+          size_limit = "1Gi"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccJobDefinitionConfig_nodeProperties(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "multinode"
+
+  node_properties {
+    main_node = 0
+    num_nodes = 2
+
+    node_range_properties {
+      target_nodes = "0:"
+
+      container {
+        command = ["ls", "-la"]
+        image   = "busybox"
+
+        resource_requirements {
+          type  = "VCPU"
+          value = "1"
+        }
+
+        resource_requirements {
+          type  = "MEMORY"
+          value = "128"
+        }
+      }
+    }
+
+    node_range_properties {
+      target_nodes = "1:"
+
+      container {
+        command = ["echo", "test"]
+        image   = "busybox"
+
+        resource_requirements {
+          type  = "VCPU"
+          value = "1"
+        }
+
+        resource_requirements {
+          type  = "MEMORY"
+          value = "128"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccJobDefinitionConfig_multinodeWithoutNodeProperties(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "test" {
+  container_properties = jsonencode({
+    command = ["echo", "test"]
+    image   = "busybox"
+    memory  = 128
+    vcpus   = 1
+  })
+  name = %[1]q
+  type = "multinode"
+}
+`, rName)
+}
@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func nodePropertiesSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"main_node": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"node_range_properties": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem:     containerPropertiesSchema(),
+						},
+						"ecs_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem:     ecsPropertiesSchema(),
+						},
+						"instance_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_nodes": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"num_nodes": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func containerPropertiesSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"command": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"environment": environmentSchema(),
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"image": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"job_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"linux_parameters":  linuxParametersSchema(),
+			"log_configuration": logConfigurationSchema(),
+			"mount_points":      mountPointsSchema(),
+			"privileged": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"readonly_root_filesystem": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"repository_credentials": repositoryCredentialsSchema(),
+			"resource_requirements":  resourceRequirementsSchema(),
+			"secrets":                secretsSchema(),
+			"ulimits":                ulimitsSchema(),
+			"user": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"volumes": volumesSchema(),
+		},
+	}
+}
+
+func expandNodeProperties(tfMap map[string]interface{}) *batch.NodeProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.NodeProperties{}
+
+	if v, ok := tfMap["main_node"].(int); ok {
+		apiObject.MainNode = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["node_range_properties"].([]interface{}); ok && len(v) > 0 {
+		apiObject.NodeRangeProperties = expandNodeRangeProperties(v)
+	}
+
+	if v, ok := tfMap["num_nodes"].(int); ok && v != 0 {
+		apiObject.NumNodes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandNodeRangeProperties(tfList []interface{}) []*batch.NodeRangeProperty {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.NodeRangeProperty
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.NodeRangeProperty{}
+
+		if v, ok := tfMap["container"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Container = expandContainerProperties(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["ecs_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.EcsProperties = expandECSProperties(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["instance_types"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.InstanceTypes = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["target_nodes"].(string); ok && v != "" {
+			apiObject.TargetNodes = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerProperties(tfMap map[string]interface{}) *batch.ContainerProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.ContainerProperties{}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["environment"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Environment = expandKeyValuePairs(v.List())
+	}
+
+	if v, ok := tfMap["execution_role_arn"].(string); ok && v != "" {
+		apiObject.ExecutionRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["image"].(string); ok && v != "" {
+		apiObject.Image = aws.String(v)
+	}
+
+	if v, ok := tfMap["instance_type"].(string); ok && v != "" {
+		apiObject.InstanceType = aws.String(v)
+	}
+
+	if v, ok := tfMap["job_role_arn"].(string); ok && v != "" {
+		apiObject.JobRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["linux_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LinuxParameters = expandLinuxParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogConfiguration = expandLogConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["mount_points"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MountPoints = expandMountPoints(v)
+	}
+
+	if v, ok := tfMap["privileged"].(bool); ok && v {
+		apiObject.Privileged = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+		apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["repository_credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RepositoryCredentials = expandRepositoryCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["resource_requirements"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceRequirements = expandResourceRequirements(v.List())
+	}
+
+	if v, ok := tfMap["secrets"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Secrets = expandSecrets(v)
+	}
+
+	if v, ok := tfMap["ulimits"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Ulimits = expandUlimits(v)
+	}
+
+	if v, ok := tfMap["user"].(string); ok && v != "" {
+		apiObject.User = aws.String(v)
+	}
+
+	if v, ok := tfMap["volumes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Volumes = expandVolumes(v)
+	}
+
+	return apiObject
+}
+
+func flattenNodeProperties(apiObject *batch.NodeProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MainNode; v != nil {
+		tfMap["main_node"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.NodeRangeProperties; v != nil {
+		tfMap["node_range_properties"] = flattenNodeRangeProperties(v)
+	}
+
+	if v := apiObject.NumNodes; v != nil {
+		tfMap["num_nodes"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenNodeRangeProperties(apiObjects []*batch.NodeRangeProperty) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Container; v != nil {
+			tfMap["container"] = []interface{}{flattenContainerPropertiesBlock(v)}
+		}
+
+		if v := apiObject.EcsProperties; v != nil {
+			tfMap["ecs_properties"] = []interface{}{flattenECSProperties(v)}
+		}
+
+		if v := apiObject.InstanceTypes; len(v) > 0 {
+			tfMap["instance_types"] = aws.StringValueSlice(v)
+		}
+
+		if v := apiObject.TargetNodes; v != nil {
+			tfMap["target_nodes"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// flattenContainerPropertiesBlock is the structured counterpart of flattenContainerProperties,
+// which renders the top-level container_properties argument as JSON.
+func flattenContainerPropertiesBlock(apiObject *batch.ContainerProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Command; v != nil {
+		tfMap["command"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Environment; v != nil {
+		tfMap["environment"] = flattenKeyValuePairs(v)
+	}
+
+	if v := apiObject.ExecutionRoleArn; v != nil {
+		tfMap["execution_role_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Image; v != nil {
+		tfMap["image"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InstanceType; v != nil {
+		tfMap["instance_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.JobRoleArn; v != nil {
+		tfMap["job_role_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LinuxParameters; v != nil {
+		tfMap["linux_parameters"] = []interface{}{flattenLinuxParameters(v)}
+	}
+
+	if v := apiObject.LogConfiguration; v != nil {
+		tfMap["log_configuration"] = []interface{}{flattenLogConfiguration(v)}
+	}
+
+	if v := apiObject.MountPoints; v != nil {
+		tfMap["mount_points"] = flattenMountPoints(v)
+	}
+
+	if v := apiObject.Privileged; v != nil {
+		tfMap["privileged"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.ReadonlyRootFilesystem; v != nil {
+		tfMap["readonly_root_filesystem"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.RepositoryCredentials; v != nil {
+		tfMap["repository_credentials"] = []interface{}{map[string]interface{}{
+			"credentials_parameter": aws.StringValue(v.CredentialsParameter),
+		}}
+	}
+
+	if v := apiObject.ResourceRequirements; v != nil {
+		tfMap["resource_requirements"] = flattenResourceRequirements(v)
+	}
+
+	if v := apiObject.Secrets; v != nil {
+		tfMap["secrets"] = flattenSecrets(v)
+	}
+
+	if v := apiObject.Ulimits; v != nil {
+		tfMap["ulimits"] = flattenUlimits(v)
+	}
+
+	if v := apiObject.User; v != nil {
+		tfMap["user"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Volumes; v != nil {
+		tfMap["volumes"] = flattenVolumes(v)
+	}
+
+	return tfMap
+}
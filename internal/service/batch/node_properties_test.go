@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfbatch "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+)
+
+func TestExpandFlattenNodeProperties(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		apiObject *batch.NodeProperties
+	}{
+		{
+			apiObject: &batch.NodeProperties{
+				MainNode: aws.Int64(0),
+				NodeRangeProperties: []*batch.NodeRangeProperty{
+					{
+						Container: &batch.ContainerProperties{
+							Command: aws.StringSlice([]string{"ls", "-la"}),
+							Image:   aws.String("busybox"),
+							ResourceRequirements: []*batch.ResourceRequirement{
+								{
+									Type:  aws.String(batch.ResourceTypeVcpu),
+									Value: aws.String("1"),
+								},
+							},
+						},
+						TargetNodes: aws.String("0:"),
+					},
+				},
+				NumNodes: aws.Int64(2),
+			},
+		},
+		{
+			apiObject: &batch.NodeProperties{
+				MainNode: aws.Int64(1),
+				NodeRangeProperties: []*batch.NodeRangeProperty{
+					{
+						Container: &batch.ContainerProperties{
+							Image: aws.String("busybox"),
+						},
+						TargetNodes: aws.String("0"),
+					},
+					{
+						EcsProperties: &batch.EcsProperties{
+							TaskProperties: []*batch.EcsTaskProperties{
+								{
+									Containers: []*batch.TaskContainerProperties{
+										{
+											Essential: aws.Bool(true),
+											Image:     aws.String("public.ecr.aws/amazonlinux/amazonlinux:1"),
+											Name:      aws.String("container_a"),
+										},
+										{
+											Essential: aws.Bool(false),
+											Image:     aws.String("public.ecr.aws/amazonlinux/amazonlinux:1"),
+											Name:      aws.String("container_b"),
+										},
+									},
+								},
+							},
+						},
+						InstanceTypes: aws.StringSlice([]string{"c5.xlarge"}),
+						TargetNodes:   aws.String("1:"),
+					},
+				},
+				NumNodes: aws.Int64(4),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		d := schema.TestResourceDataRaw(t, tfbatch.ResourceJobDefinition().Schema, map[string]interface{}{})
+
+		if err := d.Set("node_properties", []interface{}{tfbatch.FlattenNodeProperties(testCase.apiObject)}); err != nil {
+			t.Fatalf("setting node_properties: %s", err)
+		}
+
+		expanded := tfbatch.ExpandNodeProperties(d.Get("node_properties").([]interface{})[0].(map[string]interface{}))
+		if diff := cmp.Diff(expanded, testCase.apiObject); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	}
+}
@@ -0,0 +1,633 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_bedrock_custom_model", name="Custom Model")
+// @Tags(identifierAttribute="custom_model_arn")
+func resourceCustomModel() *schema.Resource {
+	s3URIDataConfigSchema := func() *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"s3_uri": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^s3://`), "must be an S3 URI"),
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomModelCreate,
+		ReadWithoutTimeout:   resourceCustomModelRead,
+		UpdateWithoutTimeout: resourceCustomModelUpdate,
+		DeleteWithoutTimeout: resourceCustomModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"base_model_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"custom_model_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_model_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"custom_model_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"customization_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.CustomizationType](),
+			},
+			"hyperparameters": {
+				Type:     schema.TypeMap,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     s3URIDataConfigSchema(),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"training_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     s3URIDataConfigSchema(),
+			},
+			"training_metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"training_loss": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"validation_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"validator": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem:     s3URIDataConfigSchema(),
+						},
+					},
+				},
+			},
+			"validation_metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"validation_loss": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 16,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCustomModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockClient(ctx)
+
+	jobName := d.Get("job_name").(string)
+	input := &bedrock.CreateModelCustomizationJobInput{
+		BaseModelIdentifier: aws.String(d.Get("base_model_identifier").(string)),
+		ClientRequestToken:  aws.String(id.UniqueId()),
+		CustomModelName:     aws.String(d.Get("custom_model_name").(string)),
+		CustomModelTags:     getTagsIn(ctx),
+		HyperParameters:     flex.ExpandStringValueMap(d.Get("hyperparameters").(map[string]interface{})),
+		JobName:             aws.String(jobName),
+		OutputDataConfig:    expandOutputDataConfig(d.Get("output_data_config").([]interface{})),
+		RoleArn:             aws.String(d.Get("role_arn").(string)),
+		TrainingDataConfig:  expandTrainingDataConfig(d.Get("training_data_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("custom_model_kms_key_id"); ok {
+		input.CustomModelKmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("customization_type"); ok {
+		input.CustomizationType = types.CustomizationType(v.(string))
+	}
+
+	if v, ok := d.GetOk("validation_data_config"); ok {
+		input.ValidationDataConfig = expandValidationDataConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandVPCConfig(v.([]interface{}))
+	}
+
+	// The role is assumed by the service to read the training data, which can fail while the role's policies propagate.
+	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*types.ValidationException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateModelCustomizationJob(ctx, input)
+	}, "Could not assume")
+
+	if err != nil {
+		return diag.Errorf("creating Bedrock Custom Model (%s): %s", jobName, err)
+	}
+
+	d.SetId(aws.ToString(outputRaw.(*bedrock.CreateModelCustomizationJobOutput).JobArn))
+
+	if _, err := waitModelCustomizationJobCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Bedrock Custom Model (%s) training job complete: %s", d.Id(), err)
+	}
+
+	return resourceCustomModelRead(ctx, d, meta)
+}
+
+func resourceCustomModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockClient(ctx)
+
+	job, err := findModelCustomizationJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Custom Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Bedrock Custom Model (%s) training job: %s", d.Id(), err)
+	}
+
+	d.Set("base_model_identifier", job.BaseModelArn)
+	d.Set("custom_model_name", job.OutputModelName)
+	d.Set("customization_type", job.CustomizationType)
+	d.Set("hyperparameters", job.HyperParameters)
+	d.Set("job_arn", job.JobArn)
+	d.Set("job_name", job.JobName)
+	d.Set("job_status", job.Status)
+	if err := d.Set("output_data_config", flattenOutputDataConfig(job.OutputDataConfig)); err != nil {
+		return diag.Errorf("setting output_data_config: %s", err)
+	}
+	d.Set("role_arn", job.RoleArn)
+	if err := d.Set("training_data_config", flattenTrainingDataConfig(job.TrainingDataConfig)); err != nil {
+		return diag.Errorf("setting training_data_config: %s", err)
+	}
+	if err := d.Set("validation_data_config", flattenValidationDataConfig(job.ValidationDataConfig)); err != nil {
+		return diag.Errorf("setting validation_data_config: %s", err)
+	}
+	if err := d.Set("vpc_config", flattenVPCConfig(job.VpcConfig)); err != nil {
+		return diag.Errorf("setting vpc_config: %s", err)
+	}
+
+	// The custom model only exists once the training job has completed.
+	if job.Status != types.ModelCustomizationJobStatusCompleted {
+		d.Set("custom_model_arn", nil)
+		d.Set("training_metrics", nil)
+		d.Set("validation_metrics", nil)
+
+		return nil
+	}
+
+	model, err := findCustomModelByID(ctx, conn, aws.ToString(job.OutputModelArn))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Custom Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Bedrock Custom Model (%s): %s", d.Id(), err)
+	}
+
+	d.Set("custom_model_arn", model.ModelArn)
+	d.Set("custom_model_kms_key_id", model.ModelKmsKeyArn)
+	if err := d.Set("training_metrics", flattenTrainingMetrics(model.TrainingMetrics)); err != nil {
+		return diag.Errorf("setting training_metrics: %s", err)
+	}
+	if err := d.Set("validation_metrics", flattenValidationMetrics(model.ValidationMetrics)); err != nil {
+		return diag.Errorf("setting validation_metrics: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCustomModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceCustomModelRead(ctx, d, meta)
+}
+
+func resourceCustomModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockClient(ctx)
+
+	if d.Get("job_status").(string) == string(types.ModelCustomizationJobStatusInProgress) {
+		log.Printf("[INFO] Stopping Bedrock Custom Model training job: %s", d.Id())
+		_, err := conn.StopModelCustomizationJob(ctx, &bedrock.StopModelCustomizationJobInput{
+			JobIdentifier: aws.String(d.Id()),
+		})
+
+		if err != nil && !errs.IsA[*types.ResourceNotFoundException](err) {
+			return diag.Errorf("stopping Bedrock Custom Model (%s) training job: %s", d.Id(), err)
+		}
+
+		if _, err := waitModelCustomizationJobStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.Errorf("waiting for Bedrock Custom Model (%s) training job stop: %s", d.Id(), err)
+		}
+
+		return nil
+	}
+
+	v, ok := d.GetOk("custom_model_arn")
+
+	if !ok {
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting Bedrock Custom Model: %s", d.Id())
+	_, err := conn.DeleteCustomModel(ctx, &bedrock.DeleteCustomModelInput{
+		ModelIdentifier: aws.String(v.(string)),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Bedrock Custom Model (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findCustomModelByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetCustomModelOutput, error) {
+	input := &bedrock.GetCustomModelInput{
+		ModelIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetCustomModel(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findModelCustomizationJobByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetModelCustomizationJobOutput, error) {
+	input := &bedrock.GetModelCustomizationJobInput{
+		JobIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetModelCustomizationJob(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusModelCustomizationJob(ctx context.Context, conn *bedrock.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findModelCustomizationJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitModelCustomizationJobCompleted(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(types.ModelCustomizationJobStatusInProgress),
+		Target:       enum.Slice(types.ModelCustomizationJobStatusCompleted),
+		Refresh:      statusModelCustomizationJob(ctx, conn, id),
+		Timeout:      timeout,
+		Delay:        1 * time.Minute,
+		PollInterval: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitModelCustomizationJobStopped(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.ModelCustomizationJobStatusInProgress, types.ModelCustomizationJobStatusStopping),
+		Target:  enum.Slice(types.ModelCustomizationJobStatusStopped),
+		Refresh: statusModelCustomizationJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandOutputDataConfig(tfList []interface{}) *types.OutputDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.OutputDataConfig{}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTrainingDataConfig(tfList []interface{}) *types.TrainingDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.TrainingDataConfig{}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandValidationDataConfig(tfList []interface{}) *types.ValidationDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.ValidationDataConfig{}
+
+	if v, ok := tfMap["validator"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Validators = append(apiObject.Validators, types.Validator{
+				S3Uri: aws.String(tfMap["s3_uri"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandVPCConfig(tfList []interface{}) *types.VpcConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.VpcConfig{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenOutputDataConfig(apiObject *types.OutputDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_uri": aws.ToString(apiObject.S3Uri),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTrainingDataConfig(apiObject *types.TrainingDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_uri": aws.ToString(apiObject.S3Uri),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenValidationDataConfig(apiObject *types.ValidationDataConfig) []interface{} {
+	if apiObject == nil || len(apiObject.Validators) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.Validators {
+		tfList = append(tfList, map[string]interface{}{
+			"s3_uri": aws.ToString(v.S3Uri),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"validator": tfList,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenVPCConfig(apiObject *types.VpcConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": apiObject.SecurityGroupIds,
+		"subnet_ids":         apiObject.SubnetIds,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTrainingMetrics(apiObject *types.TrainingMetrics) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"training_loss": float64(aws.ToFloat32(apiObject.TrainingLoss)),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenValidationMetrics(apiObjects []types.ValidatorMetric) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"validation_loss": float64(aws.ToFloat32(apiObject.ValidationLoss)),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+// Exports for use in tests only.
+var (
+	FindCustomModelByID                     = findCustomModelByID
+	FindModelCustomizationJobByID           = findModelCustomizationJobByID
+	FindModelInvocationLoggingConfiguration = findModelInvocationLoggingConfiguration
+	FindProvisionedModelThroughputByID      = findProvisionedModelThroughputByID
+
+	ResourceCustomModel                         = resourceCustomModel
+	ResourceModelInvocationLoggingConfiguration = resourceModelInvocationLoggingConfiguration
+	ResourceProvisionedModelThroughput          = resourceProvisionedModelThroughput
+)
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_bedrock_foundation_model", name="Foundation Model")
+func dataSourceFoundationModel() *schema.Resource {
+	s := foundationModelSchema()
+	s["model_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFoundationModelRead,
+
+		Schema: s,
+	}
+}
+
+func dataSourceFoundationModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockClient(ctx)
+
+	modelID := d.Get("model_id").(string)
+	model, err := findFoundationModelByID(ctx, conn, modelID)
+
+	if err != nil {
+		return diag.Errorf("reading Bedrock Foundation Model (%s): %s", modelID, err)
+	}
+
+	d.SetId(aws.ToString(model.ModelId))
+	d.Set("customizations_supported", enum.Slice(model.CustomizationsSupported...))
+	d.Set("inference_types_supported", enum.Slice(model.InferenceTypesSupported...))
+	d.Set("input_modalities", enum.Slice(model.InputModalities...))
+	d.Set("model_arn", model.ModelArn)
+	d.Set("model_id", model.ModelId)
+	d.Set("model_name", model.ModelName)
+	d.Set("output_modalities", enum.Slice(model.OutputModalities...))
+	d.Set("provider_name", model.ProviderName)
+	d.Set("response_streaming_supported", model.ResponseStreamingSupported)
+
+	return nil
+}
+
+func findFoundationModelByID(ctx context.Context, conn *bedrock.Client, id string) (*types.FoundationModelDetails, error) {
+	input := &bedrock.GetFoundationModelInput{
+		ModelIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetFoundationModel(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ModelDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ModelDetails, nil
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockFoundationModelDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_bedrock_foundation_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFoundationModelDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "model_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "model_id", "amazon.titan-text-express-v1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "model_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "provider_name", "Amazon"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "output_modalities.*", "TEXT"),
+				),
+			},
+		},
+	})
+}
+
+const testAccFoundationModelDataSourceConfig_basic = `
+data "aws_bedrock_foundation_model" "test" {
+  model_id = "amazon.titan-text-express-v1"
+}
+`
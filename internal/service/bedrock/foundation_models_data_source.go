@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+// @SDKDataSource("aws_bedrock_foundation_models", name="Foundation Models")
+func dataSourceFoundationModels() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFoundationModelsRead,
+
+		Schema: map[string]*schema.Schema{
+			"by_customization_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ModelCustomization](),
+			},
+			"by_inference_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.InferenceType](),
+			},
+			"by_output_modality": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ModelModality](),
+			},
+			"by_provider": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"model_summaries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: foundationModelSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFoundationModelsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockClient(ctx)
+
+	input := &bedrock.ListFoundationModelsInput{}
+
+	if v, ok := d.GetOk("by_customization_type"); ok {
+		input.ByCustomizationType = types.ModelCustomization(v.(string))
+	}
+
+	if v, ok := d.GetOk("by_inference_type"); ok {
+		input.ByInferenceType = types.InferenceType(v.(string))
+	}
+
+	if v, ok := d.GetOk("by_output_modality"); ok {
+		input.ByOutputModality = types.ModelModality(v.(string))
+	}
+
+	if v, ok := d.GetOk("by_provider"); ok {
+		input.ByProvider = aws.String(v.(string))
+	}
+
+	output, err := conn.ListFoundationModels(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("reading Bedrock Foundation Models: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	var tfList []interface{}
+
+	for _, v := range output.ModelSummaries {
+		tfList = append(tfList, flattenFoundationModelSummary(v))
+	}
+
+	if err := d.Set("model_summaries", tfList); err != nil {
+		return diag.Errorf("setting model_summaries: %s", err)
+	}
+
+	return nil
+}
+
+func foundationModelSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"customizations_supported": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"inference_types_supported": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"input_modalities": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"model_arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"model_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"model_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"output_modalities": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"provider_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"response_streaming_supported": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func flattenFoundationModelSummary(apiObject types.FoundationModelSummary) map[string]interface{} {
+	return map[string]interface{}{
+		"customizations_supported":     enum.Slice(apiObject.CustomizationsSupported...),
+		"inference_types_supported":    enum.Slice(apiObject.InferenceTypesSupported...),
+		"input_modalities":             enum.Slice(apiObject.InputModalities...),
+		"model_arn":                    aws.ToString(apiObject.ModelArn),
+		"model_id":                     aws.ToString(apiObject.ModelId),
+		"model_name":                   aws.ToString(apiObject.ModelName),
+		"output_modalities":            enum.Slice(apiObject.OutputModalities...),
+		"provider_name":                aws.ToString(apiObject.ProviderName),
+		"response_streaming_supported": aws.ToBool(apiObject.ResponseStreamingSupported),
+	}
+}
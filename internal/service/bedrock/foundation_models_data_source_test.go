@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockFoundationModelsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_bedrock_foundation_models.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFoundationModelsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "model_summaries.#", 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockFoundationModelsDataSource_byProvider(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_bedrock_foundation_models.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFoundationModelsDataSourceConfig_byProvider,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "model_summaries.#", 0),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "model_summaries.*", map[string]string{
+						"provider_name": "Amazon",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccFoundationModelsDataSourceConfig_basic = `
+data "aws_bedrock_foundation_models" "test" {}
+`
+
+const testAccFoundationModelsDataSourceConfig_byProvider = `
+data "aws_bedrock_foundation_models" "test" {
+  by_provider = "Amazon"
+}
+`
@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_bedrock_model_invocation_logging_configuration", name="Model Invocation Logging Configuration")
+func resourceModelInvocationLoggingConfiguration() *schema.Resource {
+	s3ConfigSchema := func() *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(3, 63),
+				},
+				"key_prefix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 1024),
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceModelInvocationLoggingConfigurationPut,
+		ReadWithoutTimeout:   resourceModelInvocationLoggingConfigurationRead,
+		UpdateWithoutTimeout: resourceModelInvocationLoggingConfigurationPut,
+		DeleteWithoutTimeout: resourceModelInvocationLoggingConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"logging_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"large_data_delivery_s3_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem:     s3ConfigSchema(),
+									},
+									"log_group_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"embedding_data_delivery_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"image_data_delivery_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"s3_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     s3ConfigSchema(),
+						},
+						"text_data_delivery_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceModelInvocationLoggingConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockClient(ctx)
+
+	input := &bedrock.PutModelInvocationLoggingConfigurationInput{
+		LoggingConfig: expandLoggingConfig(d.Get("logging_config").([]interface{})),
+	}
+
+	// Bedrock validates that it can write to the configured destinations,
+	// which can fail transiently while newly created IAM role policies propagate.
+	_, err := tfresource.RetryWhenIsAErrorMessageContains[*types.ValidationException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.PutModelInvocationLoggingConfiguration(ctx, input)
+	}, "Failed to validate permissions")
+
+	if err != nil {
+		return diag.Errorf("putting Bedrock Model Invocation Logging Configuration: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return resourceModelInvocationLoggingConfigurationRead(ctx, d, meta)
+}
+
+func resourceModelInvocationLoggingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockClient(ctx)
+
+	output, err := findModelInvocationLoggingConfiguration(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Model Invocation Logging Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Bedrock Model Invocation Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("logging_config", flattenLoggingConfig(output)); err != nil {
+		return diag.Errorf("setting logging_config: %s", err)
+	}
+
+	return nil
+}
+
+func resourceModelInvocationLoggingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockClient(ctx)
+
+	log.Printf("[INFO] Deleting Bedrock Model Invocation Logging Configuration: %s", d.Id())
+	_, err := conn.DeleteModelInvocationLoggingConfiguration(ctx, &bedrock.DeleteModelInvocationLoggingConfigurationInput{})
+
+	if err != nil {
+		return diag.Errorf("deleting Bedrock Model Invocation Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findModelInvocationLoggingConfiguration(ctx context.Context, conn *bedrock.Client) (*types.LoggingConfig, error) {
+	input := &bedrock.GetModelInvocationLoggingConfigurationInput{}
+
+	output, err := conn.GetModelInvocationLoggingConfiguration(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LoggingConfig == nil {
+		return nil, &retry.NotFoundError{LastRequest: input}
+	}
+
+	return output.LoggingConfig, nil
+}
+
+func expandLoggingConfig(tfList []interface{}) *types.LoggingConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.LoggingConfig{}
+
+	if v, ok := tfMap["cloudwatch_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchConfig = expandCloudWatchConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["embedding_data_delivery_enabled"].(bool); ok {
+		apiObject.EmbeddingDataDeliveryEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["image_data_delivery_enabled"].(bool); ok {
+		apiObject.ImageDataDeliveryEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["s3_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Config = expandS3Config(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["text_data_delivery_enabled"].(bool); ok {
+		apiObject.TextDataDeliveryEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandCloudWatchConfig(tfMap map[string]interface{}) *types.CloudWatchConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CloudWatchConfig{}
+
+	if v, ok := tfMap["large_data_delivery_s3_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LargeDataDeliveryS3Config = expandS3Config(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["log_group_name"].(string); ok && v != "" {
+		apiObject.LogGroupName = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3Config(tfMap map[string]interface{}) *types.S3Config {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3Config{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLoggingConfig(apiObject *types.LoggingConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"embedding_data_delivery_enabled": aws.ToBool(apiObject.EmbeddingDataDeliveryEnabled),
+		"image_data_delivery_enabled":     aws.ToBool(apiObject.ImageDataDeliveryEnabled),
+		"text_data_delivery_enabled":      aws.ToBool(apiObject.TextDataDeliveryEnabled),
+	}
+
+	if v := apiObject.CloudWatchConfig; v != nil {
+		tfMap["cloudwatch_config"] = flattenCloudWatchConfig(v)
+	}
+
+	if v := apiObject.S3Config; v != nil {
+		tfMap["s3_config"] = flattenS3Config(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCloudWatchConfig(apiObject *types.CloudWatchConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"log_group_name": aws.ToString(apiObject.LogGroupName),
+		"role_arn":       aws.ToString(apiObject.RoleArn),
+	}
+
+	if v := apiObject.LargeDataDeliveryS3Config; v != nil {
+		tfMap["large_data_delivery_s3_config"] = flattenS3Config(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3Config(apiObject *types.S3Config) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_name": aws.ToString(apiObject.BucketName),
+		"key_prefix":  aws.ToString(apiObject.KeyPrefix),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_bedrock_provisioned_model_throughput", name="Provisioned Model Throughput")
+// @Tags(identifierAttribute="arn")
+func resourceProvisionedModelThroughput() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProvisionedModelThroughputCreate,
+		ReadWithoutTimeout:   resourceProvisionedModelThroughputRead,
+		UpdateWithoutTimeout: resourceProvisionedModelThroughputUpdate,
+		DeleteWithoutTimeout: resourceProvisionedModelThroughputDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"commitment_duration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.CommitmentDuration](),
+			},
+			"model_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"model_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"provisioned_model_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z][a-zA-Z0-9_-]*$`), "must start with a letter or number and contain only letters, numbers, underscores and hyphens"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceProvisionedModelThroughputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockClient(ctx)
+
+	name := d.Get("provisioned_model_name").(string)
+	input := &bedrock.CreateProvisionedModelThroughputInput{
+		ClientRequestToken:   aws.String(id.UniqueId()),
+		ModelId:              aws.String(d.Get("model_arn").(string)),
+		ModelUnits:           aws.Int32(int32(d.Get("model_units").(int))),
+		ProvisionedModelName: aws.String(name),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("commitment_duration"); ok {
+		input.CommitmentDuration = types.CommitmentDuration(v.(string))
+	}
+
+	output, err := conn.CreateProvisionedModelThroughput(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Bedrock Provisioned Model Throughput (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ProvisionedModelArn))
+
+	if _, err := waitProvisionedModelThroughputInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Bedrock Provisioned Model Throughput (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceProvisionedModelThroughputRead(ctx, d, meta)
+}
+
+func resourceProvisionedModelThroughputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockClient(ctx)
+
+	output, err := findProvisionedModelThroughputByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Provisioned Model Throughput (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Bedrock Provisioned Model Throughput (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ProvisionedModelArn)
+	d.Set("commitment_duration", output.CommitmentDuration)
+	d.Set("model_arn", output.DesiredModelArn)
+	d.Set("model_units", output.DesiredModelUnits)
+	d.Set("provisioned_model_name", output.ProvisionedModelName)
+
+	return nil
+}
+
+func resourceProvisionedModelThroughputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockClient(ctx)
+
+	if d.HasChanges("model_arn", "provisioned_model_name") {
+		input := &bedrock.UpdateProvisionedModelThroughputInput{
+			ProvisionedModelId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("model_arn") {
+			input.DesiredModelId = aws.String(d.Get("model_arn").(string))
+		}
+
+		if d.HasChange("provisioned_model_name") {
+			input.DesiredProvisionedModelName = aws.String(d.Get("provisioned_model_name").(string))
+		}
+
+		_, err := conn.UpdateProvisionedModelThroughput(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Bedrock Provisioned Model Throughput (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitProvisionedModelThroughputInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Bedrock Provisioned Model Throughput (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceProvisionedModelThroughputRead(ctx, d, meta)
+}
+
+func resourceProvisionedModelThroughputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockClient(ctx)
+
+	log.Printf("[INFO] Deleting Bedrock Provisioned Model Throughput: %s", d.Id())
+	_, err := conn.DeleteProvisionedModelThroughput(ctx, &bedrock.DeleteProvisionedModelThroughputInput{
+		ProvisionedModelId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Bedrock Provisioned Model Throughput (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findProvisionedModelThroughputByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetProvisionedModelThroughputOutput, error) {
+	input := &bedrock.GetProvisionedModelThroughputInput{
+		ProvisionedModelId: aws.String(id),
+	}
+
+	output, err := conn.GetProvisionedModelThroughput(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusProvisionedModelThroughput(ctx context.Context, conn *bedrock.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findProvisionedModelThroughputByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitProvisionedModelThroughputInService(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetProvisionedModelThroughputOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.ProvisionedModelStatusCreating, types.ProvisionedModelStatusUpdating),
+		Target:  enum.Slice(types.ProvisionedModelStatusInService),
+		Refresh: statusProvisionedModelThroughput(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetProvisionedModelThroughputOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrock "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockProvisionedModelThroughput_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Provisioned throughput is billed for the full commitment term, so only run this test deliberately.
+	key := "BEDROCK_PROVISIONED_MODEL_THROUGHPUT_ENABLED"
+	if os.Getenv(key) == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v bedrock.GetProvisionedModelThroughputOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_provisioned_model_throughput.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedModelThroughputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedModelThroughputConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisionedModelThroughputExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "bedrock", regexp.MustCompile(`provisioned-model/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "commitment_duration", "OneMonth"),
+					resource.TestCheckResourceAttrPair(resourceName, "model_arn", "data.aws_bedrock_foundation_model.test", "model_arn"),
+					resource.TestCheckResourceAttr(resourceName, "model_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_model_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProvisionedModelThroughputDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrock_provisioned_model_throughput" {
+				continue
+			}
+
+			_, err := tfbedrock.FindProvisionedModelThroughputByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Provisioned Model Throughput %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProvisionedModelThroughputExists(ctx context.Context, n string, v *bedrock.GetProvisionedModelThroughputOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Bedrock Provisioned Model Throughput ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		output, err := tfbedrock.FindProvisionedModelThroughputByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccProvisionedModelThroughputConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_bedrock_foundation_model" "test" {
+  model_id = "amazon.titan-text-express-v1:0:8k"
+}
+
+resource "aws_bedrock_provisioned_model_throughput" "test" {
+  provisioned_model_name = %[1]q
+  model_arn              = data.aws_bedrock_foundation_model.test.model_arn
+  commitment_duration    = "OneMonth"
+  model_units            = 1
+
+  tags = {
+    key1 = "value1"
+  }
+}
+`, rName)
+}
@@ -0,0 +1,642 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_bedrockagent_agent", name="Agent")
+// @Tags(identifierAttribute="agent_arn")
+func resourceAgent() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAgentCreate,
+		ReadWithoutTimeout:   resourceAgentRead,
+		UpdateWithoutTimeout: resourceAgentUpdate,
+		DeleteWithoutTimeout: resourceAgentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z_-]+$`), "must contain only letters, numbers, underscores and hyphens"),
+				),
+			},
+			"agent_resource_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"agent_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_encryption_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"foundation_model": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(60, 3600),
+			},
+			"instruction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(40, 4000),
+			},
+			"prepare_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"prompt_override_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"override_lambda": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"prompt_configurations": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"base_prompt_template": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100000),
+									},
+									"inference_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"max_length": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(0, 4096),
+												},
+												"stop_sequences": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 4,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"temperature": {
+													Type:         schema.TypeFloat,
+													Required:     true,
+													ValidateFunc: validation.FloatBetween(0, 1),
+												},
+												"top_k": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(0, 500),
+												},
+												"top_p": {
+													Type:         schema.TypeFloat,
+													Required:     true,
+													ValidateFunc: validation.FloatBetween(0, 1),
+												},
+											},
+										},
+									},
+									"parser_mode": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.CreationMode](),
+									},
+									"prompt_creation_mode": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.CreationMode](),
+									},
+									"prompt_state": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.PromptState](),
+									},
+									"prompt_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.PromptType](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"skip_resource_in_use_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockAgentClient(ctx)
+
+	name := d.Get("agent_name").(string)
+	input := &bedrockagent.CreateAgentInput{
+		AgentName:            aws.String(name),
+		AgentResourceRoleArn: aws.String(d.Get("agent_resource_role_arn").(string)),
+		ClientToken:          aws.String(id.UniqueId()),
+		FoundationModel:      aws.String(d.Get("foundation_model").(string)),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("customer_encryption_key_arn"); ok {
+		input.CustomerEncryptionKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("idle_session_ttl_in_seconds"); ok {
+		input.IdleSessionTTLInSeconds = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("instruction"); ok {
+		input.Instruction = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("prompt_override_configuration"); ok {
+		input.PromptOverrideConfiguration = expandPromptOverrideConfiguration(v.([]interface{}))
+	}
+
+	// The service validates that it can assume the agent's role.
+	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ValidationException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateAgent(ctx, input)
+	}, "cannot assume role")
+
+	if err != nil {
+		return diag.Errorf("creating Bedrock Agent (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(outputRaw.(*bedrockagent.CreateAgentOutput).Agent.AgentId))
+
+	if _, err := waitAgentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Bedrock Agent (%s) create: %s", d.Id(), err)
+	}
+
+	if d.Get("prepare_agent").(bool) {
+		if err := prepareAgent(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAgentRead(ctx, d, meta)
+}
+
+func resourceAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockAgentClient(ctx)
+
+	agent, err := findAgentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Agent (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Bedrock Agent (%s): %s", d.Id(), err)
+	}
+
+	d.Set("agent_arn", agent.AgentArn)
+	d.Set("agent_id", agent.AgentId)
+	d.Set("agent_name", agent.AgentName)
+	d.Set("agent_resource_role_arn", agent.AgentResourceRoleArn)
+	d.Set("agent_version", agent.AgentVersion)
+	d.Set("customer_encryption_key_arn", agent.CustomerEncryptionKeyArn)
+	d.Set("description", agent.Description)
+	d.Set("foundation_model", agent.FoundationModel)
+	d.Set("idle_session_ttl_in_seconds", agent.IdleSessionTTLInSeconds)
+	d.Set("instruction", agent.Instruction)
+	if err := d.Set("prompt_override_configuration", flattenPromptOverrideConfiguration(agent.PromptOverrideConfiguration)); err != nil {
+		return diag.Errorf("setting prompt_override_configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAgentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockAgentClient(ctx)
+
+	if d.HasChangesExcept("prepare_agent", "skip_resource_in_use_check", names.AttrTags, names.AttrTagsAll) {
+		input := &bedrockagent.UpdateAgentInput{
+			AgentId:              aws.String(d.Id()),
+			AgentName:            aws.String(d.Get("agent_name").(string)),
+			AgentResourceRoleArn: aws.String(d.Get("agent_resource_role_arn").(string)),
+			FoundationModel:      aws.String(d.Get("foundation_model").(string)),
+		}
+
+		if v, ok := d.GetOk("customer_encryption_key_arn"); ok {
+			input.CustomerEncryptionKeyArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("idle_session_ttl_in_seconds"); ok {
+			input.IdleSessionTTLInSeconds = aws.Int32(int32(v.(int)))
+		}
+
+		if v, ok := d.GetOk("instruction"); ok {
+			input.Instruction = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("prompt_override_configuration"); ok {
+			input.PromptOverrideConfiguration = expandPromptOverrideConfiguration(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateAgent(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Bedrock Agent (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAgentUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Bedrock Agent (%s) update: %s", d.Id(), err)
+		}
+
+		if d.Get("prepare_agent").(bool) {
+			if err := prepareAgent(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceAgentRead(ctx, d, meta)
+}
+
+func resourceAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockAgentClient(ctx)
+
+	log.Printf("[INFO] Deleting Bedrock Agent: %s", d.Id())
+	_, err := conn.DeleteAgent(ctx, &bedrockagent.DeleteAgentInput{
+		AgentId:                aws.String(d.Id()),
+		SkipResourceInUseCheck: d.Get("skip_resource_in_use_check").(bool),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Bedrock Agent (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAgentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Bedrock Agent (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func prepareAgent(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) error {
+	_, err := conn.PrepareAgent(ctx, &bedrockagent.PrepareAgentInput{
+		AgentId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("preparing Bedrock Agent (%s): %w", id, err)
+	}
+
+	if _, err := waitAgentPrepared(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for Bedrock Agent (%s) prepare: %w", id, err)
+	}
+
+	return nil
+}
+
+func findAgentByID(ctx context.Context, conn *bedrockagent.Client, id string) (*awstypes.Agent, error) {
+	input := &bedrockagent.GetAgentInput{
+		AgentId: aws.String(id),
+	}
+
+	output, err := conn.GetAgent(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Agent == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Agent, nil
+}
+
+func statusAgent(ctx context.Context, conn *bedrockagent.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAgentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.AgentStatus), nil
+	}
+}
+
+func waitAgentCreated(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusCreating),
+		Target:  enum.Slice(awstypes.AgentStatusNotPrepared),
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentUpdated(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusUpdating),
+		Target:  enum.Slice(awstypes.AgentStatusNotPrepared, awstypes.AgentStatusPrepared),
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentPrepared(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusNotPrepared, awstypes.AgentStatusPreparing),
+		Target:  enum.Slice(awstypes.AgentStatusPrepared),
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentDeleted(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusDeleting),
+		Target:  []string{},
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandPromptOverrideConfiguration(tfList []interface{}) *awstypes.PromptOverrideConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.PromptOverrideConfiguration{}
+
+	if v, ok := tfMap["override_lambda"].(string); ok && v != "" {
+		apiObject.OverrideLambda = aws.String(v)
+	}
+
+	if v, ok := tfMap["prompt_configurations"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PromptConfigurations = expandPromptConfigurations(v)
+	}
+
+	return apiObject
+}
+
+func expandPromptConfigurations(tfList []interface{}) []awstypes.PromptConfiguration {
+	var apiObjects []awstypes.PromptConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.PromptConfiguration{}
+
+		if v, ok := tfMap["base_prompt_template"].(string); ok && v != "" {
+			apiObject.BasePromptTemplate = aws.String(v)
+		}
+
+		if v, ok := tfMap["inference_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.InferenceConfiguration = expandInferenceConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["parser_mode"].(string); ok && v != "" {
+			apiObject.ParserMode = awstypes.CreationMode(v)
+		}
+
+		if v, ok := tfMap["prompt_creation_mode"].(string); ok && v != "" {
+			apiObject.PromptCreationMode = awstypes.CreationMode(v)
+		}
+
+		if v, ok := tfMap["prompt_state"].(string); ok && v != "" {
+			apiObject.PromptState = awstypes.PromptState(v)
+		}
+
+		if v, ok := tfMap["prompt_type"].(string); ok && v != "" {
+			apiObject.PromptType = awstypes.PromptType(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandInferenceConfiguration(tfMap map[string]interface{}) *awstypes.InferenceConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.InferenceConfiguration{}
+
+	if v, ok := tfMap["max_length"].(int); ok {
+		apiObject.MaximumLength = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["stop_sequences"].([]interface{}); ok {
+		apiObject.StopSequences = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap["temperature"].(float64); ok {
+		apiObject.Temperature = aws.Float32(float32(v))
+	}
+
+	if v, ok := tfMap["top_k"].(int); ok {
+		apiObject.TopK = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["top_p"].(float64); ok {
+		apiObject.TopP = aws.Float32(float32(v))
+	}
+
+	return apiObject
+}
+
+func flattenPromptOverrideConfiguration(apiObject *awstypes.PromptOverrideConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"override_lambda":       aws.ToString(apiObject.OverrideLambda),
+		"prompt_configurations": flattenPromptConfigurations(apiObject.PromptConfigurations),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPromptConfigurations(apiObjects []awstypes.PromptConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"base_prompt_template": aws.ToString(apiObject.BasePromptTemplate),
+			"parser_mode":          string(apiObject.ParserMode),
+			"prompt_creation_mode": string(apiObject.PromptCreationMode),
+			"prompt_state":         string(apiObject.PromptState),
+			"prompt_type":          string(apiObject.PromptType),
+		}
+
+		if v := apiObject.InferenceConfiguration; v != nil {
+			tfMap["inference_configuration"] = []interface{}{map[string]interface{}{
+				"max_length":     aws.ToInt32(v.MaximumLength),
+				"stop_sequences": v.StopSequences,
+				"temperature":    flattenFloat32(v.Temperature),
+				"top_k":          aws.ToInt32(v.TopK),
+				"top_p":          flattenFloat32(v.TopP),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// flattenFloat32 converts a float32 to the float64 with the same shortest decimal representation,
+// so that values such as 0.9 round-trip without a spurious diff.
+func flattenFloat32(v *float32) float64 {
+	f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(aws.ToFloat32(v)), 'f', -1, 32), 64)
+
+	return f
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+// Exports for use in tests only.
+var (
+	FindAgentByID         = findAgentByID
+	FindKnowledgeBaseByID = findKnowledgeBaseByID
+
+	ResourceAgent         = resourceAgent
+	ResourceKnowledgeBase = resourceKnowledgeBase
+)
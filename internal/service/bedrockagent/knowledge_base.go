@@ -0,0 +1,780 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_bedrockagent_knowledge_base", name="Knowledge Base")
+// @Tags(identifierAttribute="arn")
+func resourceKnowledgeBase() *schema.Resource {
+	storageConfigurationPaths := []string{
+		"storage_configuration.0.opensearch_serverless_configuration",
+		"storage_configuration.0.pinecone_configuration",
+		"storage_configuration.0.rds_configuration",
+		"storage_configuration.0.redis_enterprise_cloud_configuration",
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKnowledgeBaseCreate,
+		ReadWithoutTimeout:   resourceKnowledgeBaseRead,
+		UpdateWithoutTimeout: resourceKnowledgeBaseUpdate,
+		DeleteWithoutTimeout: resourceKnowledgeBaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"failure_reasons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"knowledge_base_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.KnowledgeBaseType](),
+						},
+						"vector_knowledge_base_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"embedding_model_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"storage_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"opensearch_serverless_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: storageConfigurationPaths,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"field_mapping": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem:     vectorFieldMappingSchema(),
+									},
+									"vector_index_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"pinecone_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: storageConfigurationPaths,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"connection_string": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"credentials_secret_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"field_mapping": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metadata_field": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"text_field": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"namespace": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"rds_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: storageConfigurationPaths,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"credentials_secret_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"database_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"field_mapping": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metadata_field": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"primary_key_field": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"text_field": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"vector_field": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"resource_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"table_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"redis_enterprise_cloud_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: storageConfigurationPaths,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"credentials_secret_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"endpoint": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"field_mapping": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem:     vectorFieldMappingSchema(),
+									},
+									"vector_index_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.KnowledgeBaseStorageType](),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func vectorFieldMappingSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"metadata_field": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"text_field": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vector_field": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceKnowledgeBaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockAgentClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &bedrockagent.CreateKnowledgeBaseInput{
+		ClientToken:                aws.String(id.UniqueId()),
+		KnowledgeBaseConfiguration: expandKnowledgeBaseConfiguration(d.Get("knowledge_base_configuration").([]interface{})),
+		Name:                       aws.String(name),
+		RoleArn:                    aws.String(d.Get("role_arn").(string)),
+		StorageConfiguration:       expandStorageConfiguration(d.Get("storage_configuration").([]interface{})),
+		Tags:                       getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	// The service validates access to the vector store using the knowledge base's role,
+	// which can fail while the role's policies and the store's data access policies propagate.
+	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ValidationException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateKnowledgeBase(ctx, input)
+	}, "403 Forbidden")
+
+	if err != nil {
+		return diag.Errorf("creating Bedrock Agent Knowledge Base (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(outputRaw.(*bedrockagent.CreateKnowledgeBaseOutput).KnowledgeBase.KnowledgeBaseId))
+
+	if _, err := waitKnowledgeBaseCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Bedrock Agent Knowledge Base (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceKnowledgeBaseRead(ctx, d, meta)
+}
+
+func resourceKnowledgeBaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockAgentClient(ctx)
+
+	kb, err := findKnowledgeBaseByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Agent Knowledge Base (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Bedrock Agent Knowledge Base (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, kb.KnowledgeBaseArn)
+	d.Set("created_at", aws.ToTime(kb.CreatedAt).Format(time.RFC3339))
+	d.Set("description", kb.Description)
+	d.Set("failure_reasons", kb.FailureReasons)
+	if err := d.Set("knowledge_base_configuration", flattenKnowledgeBaseConfiguration(kb.KnowledgeBaseConfiguration)); err != nil {
+		return diag.Errorf("setting knowledge_base_configuration: %s", err)
+	}
+	d.Set(names.AttrName, kb.Name)
+	d.Set("role_arn", kb.RoleArn)
+	if err := d.Set("storage_configuration", flattenStorageConfiguration(kb.StorageConfiguration)); err != nil {
+		return diag.Errorf("setting storage_configuration: %s", err)
+	}
+	d.Set("updated_at", aws.ToTime(kb.UpdatedAt).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceKnowledgeBaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockAgentClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &bedrockagent.UpdateKnowledgeBaseInput{
+			KnowledgeBaseConfiguration: expandKnowledgeBaseConfiguration(d.Get("knowledge_base_configuration").([]interface{})),
+			KnowledgeBaseId:            aws.String(d.Id()),
+			Name:                       aws.String(d.Get(names.AttrName).(string)),
+			RoleArn:                    aws.String(d.Get("role_arn").(string)),
+			StorageConfiguration:       expandStorageConfiguration(d.Get("storage_configuration").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateKnowledgeBase(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Bedrock Agent Knowledge Base (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitKnowledgeBaseUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Bedrock Agent Knowledge Base (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceKnowledgeBaseRead(ctx, d, meta)
+}
+
+func resourceKnowledgeBaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BedrockAgentClient(ctx)
+
+	log.Printf("[INFO] Deleting Bedrock Agent Knowledge Base: %s", d.Id())
+	_, err := conn.DeleteKnowledgeBase(ctx, &bedrockagent.DeleteKnowledgeBaseInput{
+		KnowledgeBaseId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Bedrock Agent Knowledge Base (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitKnowledgeBaseDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Bedrock Agent Knowledge Base (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findKnowledgeBaseByID(ctx context.Context, conn *bedrockagent.Client, id string) (*awstypes.KnowledgeBase, error) {
+	input := &bedrockagent.GetKnowledgeBaseInput{
+		KnowledgeBaseId: aws.String(id),
+	}
+
+	output, err := conn.GetKnowledgeBase(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.KnowledgeBase == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.KnowledgeBase, nil
+}
+
+func statusKnowledgeBase(ctx context.Context, conn *bedrockagent.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findKnowledgeBaseByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitKnowledgeBaseCreated(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.KnowledgeBase, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.KnowledgeBaseStatusCreating),
+		Target:  enum.Slice(awstypes.KnowledgeBaseStatusActive),
+		Refresh: statusKnowledgeBase(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.KnowledgeBase); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKnowledgeBaseUpdated(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.KnowledgeBase, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.KnowledgeBaseStatusUpdating),
+		Target:  enum.Slice(awstypes.KnowledgeBaseStatusActive),
+		Refresh: statusKnowledgeBase(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.KnowledgeBase); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKnowledgeBaseDeleted(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.KnowledgeBase, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.KnowledgeBaseStatusActive, awstypes.KnowledgeBaseStatusDeleting),
+		Target:  []string{},
+		Refresh: statusKnowledgeBase(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.KnowledgeBase); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandKnowledgeBaseConfiguration(tfList []interface{}) *awstypes.KnowledgeBaseConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.KnowledgeBaseConfiguration{}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = awstypes.KnowledgeBaseType(v)
+	}
+
+	if v, ok := tfMap["vector_knowledge_base_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.VectorKnowledgeBaseConfiguration = &awstypes.VectorKnowledgeBaseConfiguration{
+			EmbeddingModelArn: aws.String(tfMap["embedding_model_arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandStorageConfiguration(tfList []interface{}) *awstypes.StorageConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.StorageConfiguration{}
+
+	if v, ok := tfMap["opensearch_serverless_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.OpensearchServerlessConfiguration = &awstypes.OpenSearchServerlessConfiguration{
+			CollectionArn:   aws.String(tfMap["collection_arn"].(string)),
+			VectorIndexName: aws.String(tfMap["vector_index_name"].(string)),
+		}
+
+		if v, ok := tfMap["field_mapping"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.OpensearchServerlessConfiguration.FieldMapping = &awstypes.OpenSearchServerlessFieldMapping{
+				MetadataField: aws.String(tfMap["metadata_field"].(string)),
+				TextField:     aws.String(tfMap["text_field"].(string)),
+				VectorField:   aws.String(tfMap["vector_field"].(string)),
+			}
+		}
+	}
+
+	if v, ok := tfMap["pinecone_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.PineconeConfiguration = &awstypes.PineconeConfiguration{
+			ConnectionString:     aws.String(tfMap["connection_string"].(string)),
+			CredentialsSecretArn: aws.String(tfMap["credentials_secret_arn"].(string)),
+		}
+
+		if v, ok := tfMap["namespace"].(string); ok && v != "" {
+			apiObject.PineconeConfiguration.Namespace = aws.String(v)
+		}
+
+		if v, ok := tfMap["field_mapping"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.PineconeConfiguration.FieldMapping = &awstypes.PineconeFieldMapping{
+				MetadataField: aws.String(tfMap["metadata_field"].(string)),
+				TextField:     aws.String(tfMap["text_field"].(string)),
+			}
+		}
+	}
+
+	if v, ok := tfMap["rds_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.RdsConfiguration = &awstypes.RdsConfiguration{
+			CredentialsSecretArn: aws.String(tfMap["credentials_secret_arn"].(string)),
+			DatabaseName:         aws.String(tfMap["database_name"].(string)),
+			ResourceArn:          aws.String(tfMap["resource_arn"].(string)),
+			TableName:            aws.String(tfMap["table_name"].(string)),
+		}
+
+		if v, ok := tfMap["field_mapping"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.RdsConfiguration.FieldMapping = &awstypes.RdsFieldMapping{
+				MetadataField:   aws.String(tfMap["metadata_field"].(string)),
+				PrimaryKeyField: aws.String(tfMap["primary_key_field"].(string)),
+				TextField:       aws.String(tfMap["text_field"].(string)),
+				VectorField:     aws.String(tfMap["vector_field"].(string)),
+			}
+		}
+	}
+
+	if v, ok := tfMap["redis_enterprise_cloud_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.RedisEnterpriseCloudConfiguration = &awstypes.RedisEnterpriseCloudConfiguration{
+			CredentialsSecretArn: aws.String(tfMap["credentials_secret_arn"].(string)),
+			Endpoint:             aws.String(tfMap["endpoint"].(string)),
+			VectorIndexName:      aws.String(tfMap["vector_index_name"].(string)),
+		}
+
+		if v, ok := tfMap["field_mapping"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.RedisEnterpriseCloudConfiguration.FieldMapping = &awstypes.RedisEnterpriseCloudFieldMapping{
+				MetadataField: aws.String(tfMap["metadata_field"].(string)),
+				TextField:     aws.String(tfMap["text_field"].(string)),
+				VectorField:   aws.String(tfMap["vector_field"].(string)),
+			}
+		}
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = awstypes.KnowledgeBaseStorageType(v)
+	}
+
+	return apiObject
+}
+
+func flattenKnowledgeBaseConfiguration(apiObject *awstypes.KnowledgeBaseConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"type": string(apiObject.Type),
+	}
+
+	if v := apiObject.VectorKnowledgeBaseConfiguration; v != nil {
+		tfMap["vector_knowledge_base_configuration"] = []interface{}{map[string]interface{}{
+			"embedding_model_arn": aws.ToString(v.EmbeddingModelArn),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenStorageConfiguration(apiObject *awstypes.StorageConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"type": string(apiObject.Type),
+	}
+
+	if v := apiObject.OpensearchServerlessConfiguration; v != nil {
+		m := map[string]interface{}{
+			"collection_arn":    aws.ToString(v.CollectionArn),
+			"vector_index_name": aws.ToString(v.VectorIndexName),
+		}
+
+		if v := v.FieldMapping; v != nil {
+			m["field_mapping"] = []interface{}{map[string]interface{}{
+				"metadata_field": aws.ToString(v.MetadataField),
+				"text_field":     aws.ToString(v.TextField),
+				"vector_field":   aws.ToString(v.VectorField),
+			}}
+		}
+
+		tfMap["opensearch_serverless_configuration"] = []interface{}{m}
+	}
+
+	if v := apiObject.PineconeConfiguration; v != nil {
+		m := map[string]interface{}{
+			"connection_string":      aws.ToString(v.ConnectionString),
+			"credentials_secret_arn": aws.ToString(v.CredentialsSecretArn),
+			"namespace":              aws.ToString(v.Namespace),
+		}
+
+		if v := v.FieldMapping; v != nil {
+			m["field_mapping"] = []interface{}{map[string]interface{}{
+				"metadata_field": aws.ToString(v.MetadataField),
+				"text_field":     aws.ToString(v.TextField),
+			}}
+		}
+
+		tfMap["pinecone_configuration"] = []interface{}{m}
+	}
+
+	if v := apiObject.RdsConfiguration; v != nil {
+		m := map[string]interface{}{
+			"credentials_secret_arn": aws.ToString(v.CredentialsSecretArn),
+			"database_name":          aws.ToString(v.DatabaseName),
+			"resource_arn":           aws.ToString(v.ResourceArn),
+			"table_name":             aws.ToString(v.TableName),
+		}
+
+		if v := v.FieldMapping; v != nil {
+			m["field_mapping"] = []interface{}{map[string]interface{}{
+				"metadata_field":    aws.ToString(v.MetadataField),
+				"primary_key_field": aws.ToString(v.PrimaryKeyField),
+				"text_field":        aws.ToString(v.TextField),
+				"vector_field":      aws.ToString(v.VectorField),
+			}}
+		}
+
+		tfMap["rds_configuration"] = []interface{}{m}
+	}
+
+	if v := apiObject.RedisEnterpriseCloudConfiguration; v != nil {
+		m := map[string]interface{}{
+			"credentials_secret_arn": aws.ToString(v.CredentialsSecretArn),
+			"endpoint":               aws.ToString(v.Endpoint),
+			"vector_index_name":      aws.ToString(v.VectorIndexName),
+		}
+
+		if v := v.FieldMapping; v != nil {
+			m["field_mapping"] = []interface{}{map[string]interface{}{
+				"metadata_field": aws.ToString(v.MetadataField),
+				"text_field":     aws.ToString(v.TextField),
+				"vector_field":   aws.ToString(v.VectorField),
+			}}
+		}
+
+		tfMap["redis_enterprise_cloud_configuration"] = []interface{}{m}
+	}
+
+	return []interface{}{tfMap}
+}
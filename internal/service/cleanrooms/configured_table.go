@@ -0,0 +1,699 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table")
+// @Tags(identifierAttribute="arn")
+func ResourceConfiguredTable() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableRead,
+		UpdateWithoutTimeout: resourceConfiguredTableUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allowed_columns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"analysis_method": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.AnalysisMethod](),
+			},
+			"analysis_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aggregation": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"analysis_rule.0.aggregation", "analysis_rule.0.custom", "analysis_rule.0.list"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aggregate_columns": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_names": {
+													Type:     schema.TypeSet,
+													Required: true,
+													MinItems: 1,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"function": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.AggregateFunctionName](),
+												},
+											},
+										},
+									},
+									"allowed_join_operators": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.JoinOperator](),
+										},
+									},
+									"dimension_columns": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"join_columns": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"join_required": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.JoinRequiredOption](),
+									},
+									"output_constraints": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"minimum": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(2),
+												},
+												"type": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.AggregationType](),
+												},
+											},
+										},
+									},
+									"scalar_functions": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.ScalarFunctions](),
+										},
+									},
+								},
+							},
+						},
+						"custom": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"analysis_rule.0.aggregation", "analysis_rule.0.custom", "analysis_rule.0.list"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_analyses": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"allowed_analysis_providers": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidAccountID,
+										},
+									},
+								},
+							},
+						},
+						"list": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"analysis_rule.0.aggregation", "analysis_rule.0.custom", "analysis_rule.0.list"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_join_operators": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.JoinOperator](),
+										},
+									},
+									"join_columns": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"list_columns": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"table_reference": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameConfiguredTable = "Configured Table"
+)
+
+func resourceConfiguredTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &cleanrooms.CreateConfiguredTableInput{
+		AllowedColumns: flex.ExpandStringValueSet(d.Get("allowed_columns").(*schema.Set)),
+		AnalysisMethod: types.AnalysisMethod(d.Get("analysis_method").(string)),
+		Name:           aws.String(name),
+		TableReference: expandTableReference(d.Get("table_reference").([]interface{})),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateConfiguredTable(ctx, input)
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTable, name, err)
+	}
+
+	if out == nil || out.ConfiguredTable == nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTable, name, errors.New("empty output"))
+	}
+	d.SetId(aws.ToString(out.ConfiguredTable.Id))
+
+	if v, ok := d.GetOk("analysis_rule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		ruleType, policy := expandConfiguredTableAnalysisRulePolicy(v.([]interface{})[0].(map[string]interface{}))
+
+		_, err := conn.CreateConfiguredTableAnalysisRule(ctx, &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+			AnalysisRulePolicy:        policy,
+			AnalysisRuleType:          ruleType,
+			ConfiguredTableIdentifier: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTable, d.Id(), err)
+		}
+	}
+
+	return resourceConfiguredTableRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	configuredTable, err := findConfiguredTableByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CleanRooms Configured Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTable, d.Id(), err)
+	}
+
+	d.Set("allowed_columns", configuredTable.AllowedColumns)
+	d.Set("analysis_method", configuredTable.AnalysisMethod)
+	d.Set(names.AttrARN, configuredTable.Arn)
+	d.Set("create_time", configuredTable.CreateTime.String())
+	d.Set(names.AttrDescription, configuredTable.Description)
+	d.Set(names.AttrName, configuredTable.Name)
+	if err := d.Set("table_reference", flattenTableReference(configuredTable.TableReference)); err != nil {
+		return diag.Errorf("setting table_reference: %s", err)
+	}
+	d.Set("update_time", configuredTable.UpdateTime.String())
+
+	var analysisRules []interface{}
+	for _, ruleType := range configuredTable.AnalysisRuleTypes {
+		analysisRule, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, d.Id(), ruleType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTable, d.Id(), err)
+		}
+
+		if v := flattenConfiguredTableAnalysisRulePolicy(analysisRule.Policy); v != nil {
+			analysisRules = append(analysisRules, v)
+		}
+	}
+	if err := d.Set("analysis_rule", analysisRules); err != nil {
+		return diag.Errorf("setting analysis_rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceConfiguredTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChanges(names.AttrDescription, names.AttrName) {
+		input := &cleanrooms.UpdateConfiguredTableInput{
+			ConfiguredTableIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChanges(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChanges(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		_, err := conn.UpdateConfiguredTable(ctx, input)
+		if err != nil {
+			return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTable, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("analysis_rule") {
+		var oldType types.ConfiguredTableAnalysisRuleType
+		o, n := d.GetChange("analysis_rule")
+
+		if v := o.([]interface{}); len(v) > 0 && v[0] != nil {
+			oldType, _ = expandConfiguredTableAnalysisRulePolicy(v[0].(map[string]interface{}))
+		}
+
+		if v := n.([]interface{}); len(v) > 0 && v[0] != nil {
+			newType, policy := expandConfiguredTableAnalysisRulePolicy(v[0].(map[string]interface{}))
+
+			if oldType == newType {
+				_, err := conn.UpdateConfiguredTableAnalysisRule(ctx, &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+					AnalysisRulePolicy:        policy,
+					AnalysisRuleType:          newType,
+					ConfiguredTableIdentifier: aws.String(d.Id()),
+				})
+
+				if err != nil {
+					return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTable, d.Id(), err)
+				}
+			} else {
+				// Only one analysis rule may be associated with a configured table at a time,
+				// so the old rule must be removed before the new one can be added.
+				if oldType != "" {
+					if err := deleteConfiguredTableAnalysisRule(ctx, conn, d.Id(), oldType); err != nil {
+						return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTable, d.Id(), err)
+					}
+				}
+
+				_, err := conn.CreateConfiguredTableAnalysisRule(ctx, &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+					AnalysisRulePolicy:        policy,
+					AnalysisRuleType:          newType,
+					ConfiguredTableIdentifier: aws.String(d.Id()),
+				})
+
+				if err != nil {
+					return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTable, d.Id(), err)
+				}
+			}
+		} else if oldType != "" {
+			if err := deleteConfiguredTableAnalysisRule(ctx, conn, d.Id(), oldType); err != nil {
+				return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTable, d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceConfiguredTableRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	log.Printf("[INFO] Deleting CleanRooms Configured Table %s", d.Id())
+	_, err := conn.DeleteConfiguredTable(ctx, &cleanrooms.DeleteConfiguredTableInput{
+		ConfiguredTableIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTable, d.Id(), err)
+	}
+
+	return nil
+}
+
+func deleteConfiguredTableAnalysisRule(ctx context.Context, conn *cleanrooms.Client, id string, ruleType types.ConfiguredTableAnalysisRuleType) error {
+	_, err := conn.DeleteConfiguredTableAnalysisRule(ctx, &cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(id),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func findConfiguredTableByID(ctx context.Context, conn *cleanrooms.Client, id string) (*types.ConfiguredTable, error) {
+	in := &cleanrooms.GetConfiguredTableInput{
+		ConfiguredTableIdentifier: aws.String(id),
+	}
+	out, err := conn.GetConfiguredTable(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.ConfiguredTable == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.ConfiguredTable, nil
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, id string, ruleType types.ConfiguredTableAnalysisRuleType) (*types.ConfiguredTableAnalysisRule, error) {
+	in := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(id),
+	}
+	out, err := conn.GetConfiguredTableAnalysisRule(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.AnalysisRule, nil
+}
+
+func expandTableReference(data []interface{}) types.TableReference {
+	if len(data) == 0 || data[0] == nil {
+		return nil
+	}
+
+	tfMap := data[0].(map[string]interface{})
+
+	return &types.TableReferenceMemberGlue{
+		Value: types.GlueTableReference{
+			DatabaseName: aws.String(tfMap["database_name"].(string)),
+			TableName:    aws.String(tfMap["table_name"].(string)),
+		},
+	}
+}
+
+func flattenTableReference(tableReference types.TableReference) []interface{} {
+	glue, ok := tableReference.(*types.TableReferenceMemberGlue)
+	if !ok {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"database_name": aws.ToString(glue.Value.DatabaseName),
+		"table_name":    aws.ToString(glue.Value.TableName),
+	}
+
+	return []interface{}{m}
+}
+
+func expandConfiguredTableAnalysisRulePolicy(tfMap map[string]interface{}) (types.ConfiguredTableAnalysisRuleType, types.ConfiguredTableAnalysisRulePolicy) {
+	if v, ok := tfMap["aggregation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return types.ConfiguredTableAnalysisRuleTypeAggregation, &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: &types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation{
+				Value: expandAnalysisRuleAggregation(v[0].(map[string]interface{})),
+			},
+		}
+	}
+
+	if v, ok := tfMap["custom"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return types.ConfiguredTableAnalysisRuleTypeCustom, &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: &types.ConfiguredTableAnalysisRulePolicyV1MemberCustom{
+				Value: expandAnalysisRuleCustom(v[0].(map[string]interface{})),
+			},
+		}
+	}
+
+	if v, ok := tfMap["list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return types.ConfiguredTableAnalysisRuleTypeList, &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: &types.ConfiguredTableAnalysisRulePolicyV1MemberList{
+				Value: expandAnalysisRuleList(v[0].(map[string]interface{})),
+			},
+		}
+	}
+
+	return "", nil
+}
+
+func expandAnalysisRuleAggregation(tfMap map[string]interface{}) types.AnalysisRuleAggregation {
+	apiObject := types.AnalysisRuleAggregation{
+		DimensionColumns: flex.ExpandStringValueSet(tfMap["dimension_columns"].(*schema.Set)),
+		JoinColumns:      flex.ExpandStringValueSet(tfMap["join_columns"].(*schema.Set)),
+		ScalarFunctions:  flex.ExpandStringyValueSet[types.ScalarFunctions](tfMap["scalar_functions"].(*schema.Set)),
+	}
+
+	for _, tfMapRaw := range tfMap["aggregate_columns"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject.AggregateColumns = append(apiObject.AggregateColumns, types.AggregateColumn{
+			ColumnNames: flex.ExpandStringValueSet(tfMap["column_names"].(*schema.Set)),
+			Function:    types.AggregateFunctionName(tfMap["function"].(string)),
+		})
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v)
+	}
+
+	if v, ok := tfMap["join_required"].(string); ok && v != "" {
+		apiObject.JoinRequired = types.JoinRequiredOption(v)
+	}
+
+	for _, tfMapRaw := range tfMap["output_constraints"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject.OutputConstraints = append(apiObject.OutputConstraints, types.AggregationConstraint{
+			ColumnName: aws.String(tfMap["column_name"].(string)),
+			Minimum:    aws.Int32(int32(tfMap["minimum"].(int))),
+			Type:       types.AggregationType(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleCustom(tfMap map[string]interface{}) types.AnalysisRuleCustom {
+	apiObject := types.AnalysisRuleCustom{
+		AllowedAnalyses: flex.ExpandStringValueSet(tfMap["allowed_analyses"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["allowed_analysis_providers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedAnalysisProviders = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleList(tfMap map[string]interface{}) types.AnalysisRuleList {
+	apiObject := types.AnalysisRuleList{
+		JoinColumns: flex.ExpandStringValueSet(tfMap["join_columns"].(*schema.Set)),
+		ListColumns: flex.ExpandStringValueSet(tfMap["list_columns"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v)
+	}
+
+	return apiObject
+}
+
+func flattenConfiguredTableAnalysisRulePolicy(policy types.ConfiguredTableAnalysisRulePolicy) map[string]interface{} {
+	v1, ok := policy.(*types.ConfiguredTableAnalysisRulePolicyMemberV1)
+	if !ok {
+		return nil
+	}
+
+	switch v := v1.Value.(type) {
+	case *types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation:
+		return map[string]interface{}{
+			"aggregation": []interface{}{flattenAnalysisRuleAggregation(v.Value)},
+		}
+	case *types.ConfiguredTableAnalysisRulePolicyV1MemberCustom:
+		return map[string]interface{}{
+			"custom": []interface{}{flattenAnalysisRuleCustom(v.Value)},
+		}
+	case *types.ConfiguredTableAnalysisRulePolicyV1MemberList:
+		return map[string]interface{}{
+			"list": []interface{}{flattenAnalysisRuleList(v.Value)},
+		}
+	}
+
+	return nil
+}
+
+func flattenAnalysisRuleAggregation(apiObject types.AnalysisRuleAggregation) map[string]interface{} {
+	var aggregateColumns []interface{}
+	for _, v := range apiObject.AggregateColumns {
+		aggregateColumns = append(aggregateColumns, map[string]interface{}{
+			"column_names": v.ColumnNames,
+			"function":     v.Function,
+		})
+	}
+
+	var outputConstraints []interface{}
+	for _, v := range apiObject.OutputConstraints {
+		outputConstraints = append(outputConstraints, map[string]interface{}{
+			"column_name": aws.ToString(v.ColumnName),
+			"minimum":     aws.ToInt32(v.Minimum),
+			"type":        v.Type,
+		})
+	}
+
+	return map[string]interface{}{
+		"aggregate_columns":      aggregateColumns,
+		"allowed_join_operators": enum.Slice(apiObject.AllowedJoinOperators...),
+		"dimension_columns":      apiObject.DimensionColumns,
+		"join_columns":           apiObject.JoinColumns,
+		"join_required":          apiObject.JoinRequired,
+		"output_constraints":     outputConstraints,
+		"scalar_functions":       enum.Slice(apiObject.ScalarFunctions...),
+	}
+}
+
+func flattenAnalysisRuleCustom(apiObject types.AnalysisRuleCustom) map[string]interface{} {
+	return map[string]interface{}{
+		"allowed_analyses":           apiObject.AllowedAnalyses,
+		"allowed_analysis_providers": apiObject.AllowedAnalysisProviders,
+	}
+}
+
+func flattenAnalysisRuleList(apiObject types.AnalysisRuleList) map[string]interface{} {
+	return map[string]interface{}{
+		"allowed_join_operators": enum.Slice(apiObject.AllowedJoinOperators...),
+		"join_columns":           apiObject.JoinColumns,
+		"list_columns":           apiObject.ListColumns,
+	}
+}
@@ -0,0 +1,470 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTable_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var configuredTable types.ConfiguredTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &configuredTable),
+					resource.TestCheckResourceAttr(resourceName, "allowed_columns.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_columns.*", "my_column_1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_columns.*", "my_column_2"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_method", "DIRECT_QUERY"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.#", "0"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "cleanrooms", regexp.MustCompile(`configuredtable/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_reference.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_reference.0.database_name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_reference.0.table_name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "update_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTable_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var configuredTable types.ConfiguredTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &configuredTable),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTable(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTable_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var configuredTable types.ConfiguredTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &configuredTable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &configuredTable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConfiguredTableConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &configuredTable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTable_mutableProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+	var configuredTable types.ConfiguredTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableConfig_description(rName, rName, "description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &configuredTable),
+					resource.TestCheckResourceAttr(resourceName, "description", "description"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccConfiguredTableConfig_description(rName, rNameUpdated, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &configuredTable),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTable_analysisRule(t *testing.T) {
+	ctx := acctest.Context(t)
+	var configuredTable types.ConfiguredTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableConfig_analysisRuleAggregation(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &configuredTable),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.aggregation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.aggregation.0.aggregate_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.aggregation.0.aggregate_columns.0.function", "COUNT_DISTINCT"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.aggregation.0.dimension_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.aggregation.0.join_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.aggregation.0.join_required", "QUERY_RUNNER"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.aggregation.0.output_constraints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.aggregation.0.output_constraints.0.minimum", "100"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.aggregation.0.scalar_functions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.custom.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.list.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableConfig_analysisRuleList(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &configuredTable),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.aggregation.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.list.0.allowed_join_operators.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "analysis_rule.0.list.0.allowed_join_operators.*", "OR"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.list.0.join_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.list.0.list_columns.#", "1"),
+				),
+			},
+			{
+				Config: testAccConfiguredTableConfig_analysisRuleCustom(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &configuredTable),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.custom.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.custom.0.allowed_analyses.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "analysis_rule.0.custom.0.allowed_analyses.*", "ANY_QUERY"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.0.list.#", "0"),
+				),
+			},
+			{
+				Config: testAccConfiguredTableConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &configuredTable),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table" {
+				continue
+			}
+
+			_, err := tfcleanrooms.FindConfiguredTableByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNameConfiguredTable, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableExists(ctx context.Context, name string, configuredTable *types.ConfiguredTable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTable, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTable, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindConfiguredTableByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTable, rs.Primary.ID, err)
+		}
+
+		*configuredTable = *output
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+  table_type    = "EXTERNAL_TABLE"
+
+  storage_descriptor {
+    location = "s3://${aws_s3_bucket.test.bucket}"
+
+    columns {
+      name = "my_column_1"
+      type = "string"
+    }
+
+    columns {
+      name = "my_column_2"
+      type = "string"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccConfiguredTableConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+}
+`, rName))
+}
+
+func testAccConfiguredTableConfig_description(rName, name, description string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  description     = %[2]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+}
+`, name, description))
+}
+
+func testAccConfiguredTableConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccConfiguredTableConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccConfiguredTableConfig_analysisRuleAggregation(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+
+  analysis_rule {
+    aggregation {
+      aggregate_columns {
+        column_names = ["my_column_2"]
+        function     = "COUNT_DISTINCT"
+      }
+
+      dimension_columns = ["my_column_1"]
+      join_columns      = ["my_column_2"]
+      join_required     = "QUERY_RUNNER"
+      scalar_functions  = ["TRUNC", "UPPER"]
+
+      output_constraints {
+        column_name = "my_column_2"
+        minimum     = 100
+        type        = "COUNT_DISTINCT"
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccConfiguredTableConfig_analysisRuleList(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+
+  analysis_rule {
+    list {
+      allowed_join_operators = ["OR"]
+      join_columns           = ["my_column_1"]
+      list_columns           = ["my_column_2"]
+    }
+  }
+}
+`, rName))
+}
+
+func testAccConfiguredTableConfig_analysisRuleCustom(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+
+  analysis_rule {
+    custom {
+      allowed_analyses = ["ANY_QUERY"]
+    }
+  }
+}
+`, rName))
+}
@@ -11,6 +11,11 @@ const (
 	ResNameOriginAccessIdentity = "Origin Access Identity"
 )
 
+const (
+	KeyValueStoreStatusProvisioning = "PROVISIONING"
+	KeyValueStoreStatusReady        = "READY"
+)
+
 func StreamType_Values() []string {
 	return []string{
 		StreamTypeKinesis,
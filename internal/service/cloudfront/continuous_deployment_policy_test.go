@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontContinuousDeploymentPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy cloudfront.GetContinuousDeploymentPolicyOutput
+	var stagingDistribution, productionDistribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+	productionDistributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_basic(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, stagingDistributionResourceName, &stagingDistribution),
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName, &policy),
+					testAccCheckDistributionExists(ctx, productionDistributionResourceName, &productionDistribution),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_time"),
+					resource.TestCheckResourceAttr(resourceName, "staging_distribution_dns_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "staging_distribution_dns_names.0.quantity", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "staging_distribution_dns_names.0.items.*", stagingDistributionResourceName, "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.type", "SingleWeight"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.weight", "0.01"),
+					resource.TestCheckResourceAttr(stagingDistributionResourceName, "staging", "true"),
+					resource.TestCheckResourceAttrPair(productionDistributionResourceName, "continuous_deployment_policy_id", resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_basic(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontContinuousDeploymentPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy cloudfront.GetContinuousDeploymentPolicyOutput
+	var stagingDistribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_policyOnly(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, stagingDistributionResourceName, &stagingDistribution),
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName, &policy),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudfront.ResourceContinuousDeploymentPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontContinuousDeploymentPolicy_trafficConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy cloudfront.GetContinuousDeploymentPolicyOutput
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_trafficConfigSingleWeight(0.01, 300, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.type", "SingleWeight"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.weight", "0.01"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.idle_ttl", "300"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.maximum_ttl", "600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_trafficConfigSingleWeight(0.02, 600, 1200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.weight", "0.02"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.idle_ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.maximum_ttl", "1200"),
+				),
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_trafficConfigSingleHeader("aws-cf-cd-test", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.type", "SingleHeader"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.0.header", "aws-cf-cd-test"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.0.value", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContinuousDeploymentPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudfront_continuous_deployment_policy" {
+				continue
+			}
+
+			_, err := tfcloudfront.FindContinuousDeploymentPolicyByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CloudFront, create.ErrActionCheckingDestroyed, tfcloudfront.ResNameContinuousDeploymentPolicy, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckContinuousDeploymentPolicyExists(ctx context.Context, n string, v *cloudfront.GetContinuousDeploymentPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Continuous Deployment Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn(ctx)
+
+		output, err := tfcloudfront.FindContinuousDeploymentPolicyByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccContinuousDeploymentPolicyConfig_stagingDistribution() string {
+	return `
+resource "aws_cloudfront_distribution" "staging" {
+  enabled          = false
+  retain_on_delete = false
+  staging          = true
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "all"
+      }
+    }
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`
+}
+
+func testAccContinuousDeploymentPolicyConfig_policyOnly() string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_stagingDistribution(), `
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = false
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+
+    single_weight_config {
+      weight = "0.01"
+    }
+  }
+}
+`)
+}
+
+func testAccContinuousDeploymentPolicyConfig_basic(enabled bool) string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_stagingDistribution(), fmt.Sprintf(`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = %[1]t
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+
+    single_weight_config {
+      weight = "0.01"
+    }
+  }
+}
+
+resource "aws_cloudfront_distribution" "test" {
+  enabled                         = true
+  retain_on_delete                = false
+  continuous_deployment_policy_id = aws_cloudfront_continuous_deployment_policy.test.id
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "all"
+      }
+    }
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`, enabled))
+}
+
+func testAccContinuousDeploymentPolicyConfig_trafficConfigSingleWeight(weight float64, idleTTL, maximumTTL int) string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_stagingDistribution(), fmt.Sprintf(`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = false
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+
+    single_weight_config {
+      weight = %[1]g
+
+      session_stickiness_config {
+        idle_ttl    = %[2]d
+        maximum_ttl = %[3]d
+      }
+    }
+  }
+}
+`, weight, idleTTL, maximumTTL))
+}
+
+func testAccContinuousDeploymentPolicyConfig_trafficConfigSingleHeader(header, value string) string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_stagingDistribution(), fmt.Sprintf(`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = false
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleHeader"
+
+    single_header_config {
+      header = %[1]q
+      value  = %[2]q
+    }
+  }
+}
+`, header, value))
+}
@@ -5,7 +5,9 @@ package cloudfront
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"reflect"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -55,6 +57,16 @@ func ResourceDistribution() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      AliasesHash,
 			},
+			"continuous_deployment_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// promote_staging_distribution_id is a non-API attribute. When set,
+			// configuration changes are applied by promoting the staging distribution.
+			"promote_staging_distribution_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"ordered_cache_behavior": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -719,6 +731,12 @@ func ResourceDistribution() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"staging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -929,6 +947,27 @@ func resourceDistributionRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	if v, ok := d.GetOk("promote_staging_distribution_id"); ok && d.HasChangesExcept("promote_staging_distribution_id", "retain_on_delete", "wait_for_deployment", names.AttrTags, names.AttrTagsAll) {
+		stagingID := v.(string)
+		promoted, err := promoteStagingDistribution(ctx, conn, d.Id(), stagingID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "promoting CloudFront Distribution (%s) to CloudFront Distribution (%s): %s", stagingID, d.Id(), err)
+		}
+
+		if promoted {
+			if d.Get("wait_for_deployment").(bool) {
+				log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
+				if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+				}
+			}
+
+			return append(diags, resourceDistributionRead(ctx, d, meta)...)
+		}
+	}
+
 	params := &cloudfront.UpdateDistributionInput{
 		Id:                 aws.String(d.Id()),
 		DistributionConfig: expandDistributionConfig(d),
@@ -1033,6 +1072,22 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 		IfMatch: aws.String(d.Get("etag").(string)),
 	}
 
+	// A continuous deployment policy can only be deleted once it is no longer
+	// attached to a primary distribution, so detach it first.
+	if v := d.Get("continuous_deployment_policy_id").(string); v != "" {
+		etag, err := detachContinuousDeploymentPolicy(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "detaching CloudFront Continuous Deployment Policy (%s) from Distribution (%s): %s", v, d.Id(), err)
+		}
+
+		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+		}
+
+		deleteDistroInput.IfMatch = etag
+	}
+
 	log.Printf("[DEBUG] Deleting CloudFront Distribution: %s", d.Id())
 	_, err := conn.DeleteDistributionWithContext(ctx, deleteDistroInput)
 
@@ -1108,6 +1163,89 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// detachContinuousDeploymentPolicy removes any continuous deployment policy
+// from the specified primary distribution and returns the new ETag.
+func detachContinuousDeploymentPolicy(ctx context.Context, conn *cloudfront.CloudFront, id string) (*string, error) {
+	output, err := FindDistributionByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(output.Distribution.DistributionConfig.ContinuousDeploymentPolicyId) == "" {
+		return output.ETag, nil
+	}
+
+	input := &cloudfront.UpdateDistributionInput{
+		DistributionConfig: output.Distribution.DistributionConfig,
+		Id:                 aws.String(id),
+		IfMatch:            output.ETag,
+	}
+	input.DistributionConfig.ContinuousDeploymentPolicyId = aws.String("")
+
+	updateOutput, err := conn.UpdateDistributionWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return updateOutput.ETag, nil
+}
+
+// promoteStagingDistribution copies the configuration of the specified staging
+// distribution to its primary distribution. No promotion is made, and false is
+// returned, if the primary distribution already has the staging configuration.
+func promoteStagingDistribution(ctx context.Context, conn *cloudfront.CloudFront, id, stagingID string) (bool, error) {
+	primary, err := FindDistributionByID(ctx, conn, id)
+
+	if err != nil {
+		return false, err
+	}
+
+	staging, err := FindDistributionByID(ctx, conn, stagingID)
+
+	if err != nil {
+		return false, err
+	}
+
+	if distributionConfigPromoted(primary.Distribution.DistributionConfig, staging.Distribution.DistributionConfig) {
+		return false, nil
+	}
+
+	input := &cloudfront.UpdateDistributionWithStagingConfigInput{
+		Id:                    aws.String(id),
+		IfMatch:               aws.String(fmt.Sprintf("%s, %s", aws.StringValue(primary.ETag), aws.StringValue(staging.ETag))),
+		StagingDistributionId: aws.String(stagingID),
+	}
+
+	_, err = conn.UpdateDistributionWithStagingConfigWithContext(ctx, input)
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// distributionConfigPromoted returns whether the primary distribution configuration
+// matches the staging one, ignoring the values a primary retains on promotion.
+func distributionConfigPromoted(primary, staging *cloudfront.DistributionConfig) bool {
+	if primary == nil || staging == nil {
+		return false
+	}
+
+	p, s := *primary, *staging
+
+	for _, c := range []*cloudfront.DistributionConfig{&p, &s} {
+		c.Aliases = nil
+		c.CallerReference = nil
+		c.ContinuousDeploymentPolicyId = nil
+		c.Staging = nil
+	}
+
+	return reflect.DeepEqual(p, s)
+}
+
 func FindDistributionByID(ctx context.Context, conn *cloudfront.CloudFront, id string) (*cloudfront.GetDistributionOutput, error) {
 	input := &cloudfront.GetDistributionInput{
 		Id: aws.String(id),
@@ -1495,6 +1495,51 @@ func testAccCheckDistributionDestroy(ctx context.Context) resource.TestCheckFunc
 	}
 }
 
+func TestAccCloudFrontDistribution_promoteStagingDistribution(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var distribution, stagingDistribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_distribution.test"
+	stagingResourceName := "aws_cloudfront_distribution.staging"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfig_promoteStagingDistribution("v1.html", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					testAccCheckDistributionExists(ctx, stagingResourceName, &stagingDistribution),
+					resource.TestCheckResourceAttr(resourceName, "default_root_object", "v1.html"),
+					resource.TestCheckResourceAttr(resourceName, "promote_staging_distribution_id", ""),
+					resource.TestCheckResourceAttr(stagingResourceName, "default_root_object", "v2.html"),
+				),
+			},
+			{
+				Config: testAccDistributionConfig_promoteStagingDistribution("v2.html", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					resource.TestCheckResourceAttr(resourceName, "default_root_object", "v2.html"),
+					resource.TestCheckResourceAttrPair(resourceName, "continuous_deployment_policy_id", "aws_cloudfront_continuous_deployment_policy.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "promote_staging_distribution_id", stagingResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "staging", "false"),
+				),
+			},
+			// The promoted configuration must not be reverted.
+			{
+				Config:   testAccDistributionConfig_promoteStagingDistribution("v2.html", true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDistributionExists(ctx context.Context, resourceName string, distribution *cloudfront.Distribution) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -4501,3 +4546,117 @@ resource "aws_cloudfront_distribution" "test" {
 }
 `, rName, testAccDistributionRetainConfig(), which))
 }
+
+func testAccDistributionConfig_promoteStagingDistribution(defaultRootObject string, promote bool) string {
+	return fmt.Sprintf(`
+locals {
+  promote = %[2]t
+}
+
+resource "aws_cloudfront_distribution" "staging" {
+  default_root_object = "v2.html"
+  enabled             = true
+  retain_on_delete    = false
+  staging             = true
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "all"
+      }
+    }
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = true
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+
+    single_weight_config {
+      weight = "0.01"
+    }
+  }
+}
+
+resource "aws_cloudfront_distribution" "test" {
+  continuous_deployment_policy_id = aws_cloudfront_continuous_deployment_policy.test.id
+  default_root_object             = %[1]q
+  enabled                         = true
+  promote_staging_distribution_id = local.promote ? aws_cloudfront_distribution.staging.id : null
+  retain_on_delete                = false
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "all"
+      }
+    }
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`, defaultRootObject, promote)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_cloudfront_function")
@@ -46,6 +47,14 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"key_value_store_associations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
 			"live_stage_etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -87,6 +96,10 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		Name: aws.String(functionName),
 	}
 
+	if v, ok := d.GetOk("key_value_store_associations"); ok {
+		input.FunctionConfig.KeyValueStoreAssociations = expandKeyValueStoreAssociations(v.(*schema.Set).List())
+	}
+
 	log.Printf("[DEBUG] Creating CloudFront Function: %s", functionName)
 	output, err := conn.CreateFunctionWithContext(ctx, input)
 
@@ -132,6 +145,9 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("arn", describeFunctionOutput.FunctionSummary.FunctionMetadata.FunctionARN)
 	d.Set("comment", describeFunctionOutput.FunctionSummary.FunctionConfig.Comment)
 	d.Set("etag", describeFunctionOutput.ETag)
+	if err := d.Set("key_value_store_associations", flattenKeyValueStoreAssociations(describeFunctionOutput.FunctionSummary.FunctionConfig.KeyValueStoreAssociations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting key_value_store_associations: %s", err)
+	}
 	d.Set("name", describeFunctionOutput.FunctionSummary.Name)
 	d.Set("runtime", describeFunctionOutput.FunctionSummary.FunctionConfig.Runtime)
 	d.Set("status", describeFunctionOutput.FunctionSummary.Status)
@@ -165,7 +181,7 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
 	etag := d.Get("etag").(string)
 
-	if d.HasChanges("code", "comment", "runtime") {
+	if d.HasChanges("code", "comment", "key_value_store_associations", "runtime") {
 		input := &cloudfront.UpdateFunctionInput{
 			FunctionCode: []byte(d.Get("code").(string)),
 			FunctionConfig: &cloudfront.FunctionConfig{
@@ -176,6 +192,10 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			IfMatch: aws.String(etag),
 		}
 
+		if v, ok := d.GetOk("key_value_store_associations"); ok {
+			input.FunctionConfig.KeyValueStoreAssociations = expandKeyValueStoreAssociations(v.(*schema.Set).List())
+		}
+
 		log.Printf("[INFO] Updating CloudFront Function: %s", d.Id())
 		output, err := conn.UpdateFunctionWithContext(ctx, input)
 
@@ -223,3 +243,46 @@ func resourceFunctionDelete(ctx context.Context, d *schema.ResourceData, meta in
 
 	return diags
 }
+
+func expandKeyValueStoreAssociations(tfList []interface{}) *cloudfront.KeyValueStoreAssociations {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var items []*cloudfront.KeyValueStoreAssociation
+
+	for _, tfListRaw := range tfList {
+		arn, ok := tfListRaw.(string)
+
+		if !ok {
+			continue
+		}
+
+		items = append(items, &cloudfront.KeyValueStoreAssociation{
+			KeyValueStoreARN: aws.String(arn),
+		})
+	}
+
+	return &cloudfront.KeyValueStoreAssociations{
+		Items:    items,
+		Quantity: aws.Int64(int64(len(items))),
+	}
+}
+
+func flattenKeyValueStoreAssociations(apiObject *cloudfront.KeyValueStoreAssociations) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, item := range apiObject.Items {
+		if item == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(item.KeyValueStoreARN))
+	}
+
+	return tfList
+}
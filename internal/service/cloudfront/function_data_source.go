@@ -42,6 +42,12 @@ func DataSourceFunction() *schema.Resource {
 				Computed: true,
 			},
 
+			"key_value_store_associations": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"last_modified_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -87,6 +93,9 @@ func dataSourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("arn", describeFunctionOutput.FunctionSummary.FunctionMetadata.FunctionARN)
 	d.Set("comment", describeFunctionOutput.FunctionSummary.FunctionConfig.Comment)
 	d.Set("etag", describeFunctionOutput.ETag)
+	if err := d.Set("key_value_store_associations", flattenKeyValueStoreAssociations(describeFunctionOutput.FunctionSummary.FunctionConfig.KeyValueStoreAssociations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting key_value_store_associations: %s", err)
+	}
 	d.Set("last_modified_time", describeFunctionOutput.FunctionSummary.FunctionMetadata.LastModifiedTime.Format(time.RFC3339))
 	d.Set("name", describeFunctionOutput.FunctionSummary.Name)
 	d.Set("runtime", describeFunctionOutput.FunctionSummary.FunctionConfig.Runtime)
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudfront_key_value_store", name="Key Value Store")
+func ResourceKeyValueStore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyValueStoreCreate,
+		ReadWithoutTimeout:   resourceKeyValueStoreRead,
+		UpdateWithoutTimeout: resourceKeyValueStoreUpdate,
+		DeleteWithoutTimeout: resourceKeyValueStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+		},
+	}
+}
+
+const (
+	ResNameKeyValueStore = "Key Value Store"
+)
+
+func resourceKeyValueStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	name := d.Get("name").(string)
+	in := &cloudfront.CreateKeyValueStoreInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		in.Comment = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateKeyValueStoreWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameKeyValueStore, name, err)
+	}
+
+	if out == nil || out.KeyValueStore == nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameKeyValueStore, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.KeyValueStore.Name))
+
+	if _, err := waitKeyValueStoreCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionWaitingForCreation, ResNameKeyValueStore, d.Id(), err)
+	}
+
+	return resourceKeyValueStoreRead(ctx, d, meta)
+}
+
+func resourceKeyValueStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	out, err := FindKeyValueStoreByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Key Value Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionReading, ResNameKeyValueStore, d.Id(), err)
+	}
+
+	kvs := out.KeyValueStore
+
+	d.Set("arn", kvs.ARN)
+	d.Set("comment", kvs.Comment)
+	d.Set("etag", out.ETag)
+	d.Set("last_modified_time", aws.TimeValue(kvs.LastModifiedTime).Format(time.RFC3339))
+	d.Set("name", kvs.Name)
+
+	return nil
+}
+
+func resourceKeyValueStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	in := &cloudfront.UpdateKeyValueStoreInput{
+		Comment: aws.String(d.Get("comment").(string)),
+		IfMatch: aws.String(d.Get("etag").(string)),
+		Name:    aws.String(d.Id()),
+	}
+
+	_, err := conn.UpdateKeyValueStoreWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionUpdating, ResNameKeyValueStore, d.Id(), err)
+	}
+
+	return resourceKeyValueStoreRead(ctx, d, meta)
+}
+
+func resourceKeyValueStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	log.Printf("[INFO] Deleting CloudFront Key Value Store %s", d.Id())
+	_, err := conn.DeleteKeyValueStoreWithContext(ctx, &cloudfront.DeleteKeyValueStoreInput{
+		IfMatch: aws.String(d.Get("etag").(string)),
+		Name:    aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeEntityNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionDeleting, ResNameKeyValueStore, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindKeyValueStoreByName(ctx context.Context, conn *cloudfront.CloudFront, name string) (*cloudfront.DescribeKeyValueStoreOutput, error) {
+	in := &cloudfront.DescribeKeyValueStoreInput{
+		Name: aws.String(name),
+	}
+
+	out, err := conn.DescribeKeyValueStoreWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeEntityNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.KeyValueStore == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func statusKeyValueStore(ctx context.Context, conn *cloudfront.CloudFront, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindKeyValueStoreByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.KeyValueStore.Status), nil
+	}
+}
+
+func waitKeyValueStoreCreated(ctx context.Context, conn *cloudfront.CloudFront, name string, timeout time.Duration) (*cloudfront.DescribeKeyValueStoreOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{KeyValueStoreStatusProvisioning},
+		Target:  []string{KeyValueStoreStatusReady},
+		Refresh: statusKeyValueStore(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*cloudfront.DescribeKeyValueStoreOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
@@ -5,6 +5,8 @@ package cloudfront
 import (
 	"context"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	cloudfront_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	cloudfront_sdkv1 "github.com/aws/aws-sdk-go/service/cloudfront"
@@ -70,6 +72,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceCachePolicy,
 			TypeName: "aws_cloudfront_cache_policy",
 		},
+		{
+			Factory:  ResourceContinuousDeploymentPolicy,
+			TypeName: "aws_cloudfront_continuous_deployment_policy",
+			Name:     "Continuous Deployment Policy",
+		},
 		{
 			Factory:  ResourceDistribution,
 			TypeName: "aws_cloudfront_distribution",
@@ -94,6 +101,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceKeyGroup,
 			TypeName: "aws_cloudfront_key_group",
 		},
+		{
+			Factory:  ResourceKeyValueStore,
+			TypeName: "aws_cloudfront_key_value_store",
+			Name:     "Key Value Store",
+		},
 		{
 			Factory:  ResourceMonitoringSubscription,
 			TypeName: "aws_cloudfront_monitoring_subscription",
@@ -122,6 +134,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceResponseHeadersPolicy,
 			TypeName: "aws_cloudfront_response_headers_policy",
 		},
+		{
+			Factory:  ResourceVPCOrigin,
+			TypeName: "aws_cloudfront_vpc_origin",
+			Name:     "VPC Origin",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 	}
 }
 
@@ -136,6 +156,17 @@ func (p *servicePackage) NewConn(ctx context.Context, config map[string]any) (*c
 	return cloudfront_sdkv1.New(sess.Copy(&aws_sdkv1.Config{Endpoint: aws_sdkv1.String(config["endpoint"].(string))})), nil
 }
 
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*cloudfront_sdkv2.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
+
+	return cloudfront_sdkv2.NewFromConfig(cfg, func(o *cloudfront_sdkv2.Options) {
+		if endpoint := config["endpoint"].(string); endpoint != "" {
+			o.EndpointResolver = cloudfront_sdkv2.EndpointResolverFromURL(endpoint)
+		}
+	}), nil
+}
+
 func ServicePackage(ctx context.Context) conns.ServicePackage {
 	return &servicePackage{}
 }
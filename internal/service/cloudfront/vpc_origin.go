@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudfront_vpc_origin", name="VPC Origin")
+// @Tags(identifierAttribute="arn")
+func ResourceVPCOrigin() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCOriginCreate,
+		ReadWithoutTimeout:   resourceVPCOriginRead,
+		UpdateWithoutTimeout: resourceVPCOriginUpdate,
+		DeleteWithoutTimeout: resourceVPCOriginDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_origin_endpoint_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"http_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"https_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"origin_protocol_policy": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.OriginProtocolPolicy](),
+						},
+						"origin_ssl_protocols": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"items": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[awstypes.SslProtocol](),
+										},
+									},
+									"quantity": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameVPCOrigin = "VPC Origin"
+
+	vpcOriginStatusDeployed  = "Deployed"
+	vpcOriginStatusDeploying = "Deploying"
+)
+
+func resourceVPCOriginCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	in := &cloudfront.CreateVpcOriginInput{
+		VpcOriginEndpointConfig: expandVPCOriginEndpointConfig(d.Get("vpc_origin_endpoint_config").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if tags := getTagsIn(ctx); len(tags) > 0 {
+		in.Tags = &awstypes.Tags{}
+
+		for k, v := range KeyValueTags(ctx, tags).Map() {
+			in.Tags.Items = append(in.Tags.Items, awstypes.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+	}
+
+	out, err := conn.CreateVpcOrigin(ctx, in)
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameVPCOrigin, aws.ToString(in.VpcOriginEndpointConfig.Name), err)
+	}
+
+	if out == nil || out.VpcOrigin == nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameVPCOrigin, aws.ToString(in.VpcOriginEndpointConfig.Name), errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.VpcOrigin.Id))
+
+	if _, err := waitVPCOriginDeployed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionWaitingForCreation, ResNameVPCOrigin, d.Id(), err)
+	}
+
+	return resourceVPCOriginRead(ctx, d, meta)
+}
+
+func resourceVPCOriginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	out, err := FindVPCOriginByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront VPC Origin (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionReading, ResNameVPCOrigin, d.Id(), err)
+	}
+
+	d.Set("arn", out.VpcOrigin.Arn)
+	d.Set("etag", out.ETag)
+	if err := d.Set("vpc_origin_endpoint_config", flattenVPCOriginEndpointConfig(out.VpcOrigin.VpcOriginEndpointConfig)); err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionSetting, ResNameVPCOrigin, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceVPCOriginUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	if d.HasChange("vpc_origin_endpoint_config") {
+		in := &cloudfront.UpdateVpcOriginInput{
+			Id:                      aws.String(d.Id()),
+			IfMatch:                 aws.String(d.Get("etag").(string)),
+			VpcOriginEndpointConfig: expandVPCOriginEndpointConfig(d.Get("vpc_origin_endpoint_config").([]interface{})[0].(map[string]interface{})),
+		}
+
+		_, err := conn.UpdateVpcOrigin(ctx, in)
+		if err != nil {
+			return create.DiagError(names.CloudFront, create.ErrActionUpdating, ResNameVPCOrigin, d.Id(), err)
+		}
+
+		if _, err := waitVPCOriginDeployed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.CloudFront, create.ErrActionWaitingForUpdate, ResNameVPCOrigin, d.Id(), err)
+		}
+	}
+
+	return resourceVPCOriginRead(ctx, d, meta)
+}
+
+func resourceVPCOriginDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	log.Printf("[INFO] Deleting CloudFront VPC Origin %s", d.Id())
+	_, err := conn.DeleteVpcOrigin(ctx, &cloudfront.DeleteVpcOriginInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	})
+
+	if errs.IsA[*awstypes.EntityNotFound](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionDeleting, ResNameVPCOrigin, d.Id(), err)
+	}
+
+	if _, err := waitVPCOriginDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionWaitingForDeletion, ResNameVPCOrigin, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindVPCOriginByID(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetVpcOriginOutput, error) {
+	in := &cloudfront.GetVpcOriginInput{
+		Id: aws.String(id),
+	}
+
+	out, err := conn.GetVpcOrigin(ctx, in)
+
+	if errs.IsA[*awstypes.EntityNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.VpcOrigin == nil || out.VpcOrigin.VpcOriginEndpointConfig == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func statusVPCOrigin(ctx context.Context, conn *cloudfront.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindVPCOriginByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.ToString(out.VpcOrigin.Status), nil
+	}
+}
+
+func waitVPCOriginDeployed(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) (*cloudfront.GetVpcOriginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{vpcOriginStatusDeploying},
+		Target:  []string{vpcOriginStatusDeployed},
+		Refresh: statusVPCOrigin(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*cloudfront.GetVpcOriginOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitVPCOriginDeleted(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) (*cloudfront.GetVpcOriginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{vpcOriginStatusDeployed, vpcOriginStatusDeploying},
+		Target:  []string{},
+		Refresh: statusVPCOrigin(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*cloudfront.GetVpcOriginOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func expandVPCOriginEndpointConfig(tfMap map[string]interface{}) *awstypes.VpcOriginEndpointConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.VpcOriginEndpointConfig{}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.Arn = aws.String(v)
+	}
+
+	if v, ok := tfMap["http_port"].(int); ok {
+		apiObject.HTTPPort = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["https_port"].(int); ok {
+		apiObject.HTTPSPort = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["origin_protocol_policy"].(string); ok && v != "" {
+		apiObject.OriginProtocolPolicy = awstypes.OriginProtocolPolicy(v)
+	}
+
+	if v, ok := tfMap["origin_ssl_protocols"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.OriginSslProtocols = &awstypes.OriginSslProtocols{
+			Items:    flex.ExpandStringyValueSet[awstypes.SslProtocol](tfMap["items"].(*schema.Set)),
+			Quantity: aws.Int32(int32(tfMap["quantity"].(int))),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenVPCOriginEndpointConfig(apiObject *awstypes.VpcOriginEndpointConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":                    aws.ToString(apiObject.Arn),
+		"http_port":              aws.ToInt32(apiObject.HTTPPort),
+		"https_port":             aws.ToInt32(apiObject.HTTPSPort),
+		"name":                   aws.ToString(apiObject.Name),
+		"origin_protocol_policy": string(apiObject.OriginProtocolPolicy),
+	}
+
+	if v := apiObject.OriginSslProtocols; v != nil {
+		tfMap["origin_ssl_protocols"] = []interface{}{map[string]interface{}{
+			"items":    enum.Slice(v.Items...),
+			"quantity": aws.ToInt32(v.Quantity),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
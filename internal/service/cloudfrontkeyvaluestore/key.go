@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontkeyvaluestore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_cloudfrontkeyvaluestore_key", name="Key")
+func resourceKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyCreate,
+		ReadWithoutTimeout:   resourceKeyRead,
+		UpdateWithoutTimeout: resourceKeyUpdate,
+		DeleteWithoutTimeout: resourceKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"key_value_store_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"total_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+const (
+	// Keys are written against the key value store's current ETag, so concurrent
+	// writes to the same store conflict. Retry those for a short while.
+	keyWriteTimeout = 2 * time.Minute
+)
+
+func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN, key := d.Get("key_value_store_arn").(string), d.Get("key").(string)
+	id := keyCreateResourceID(kvsARN, key)
+
+	if err := putKey(ctx, conn, kvsARN, key, d.Get("value").(string)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudFront KeyValueStore Key (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN, key, err := keyParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findKeyByTwoPartKey(ctx, conn, kvsARN, key)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront KeyValueStore Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFront KeyValueStore Key (%s): %s", d.Id(), err)
+	}
+
+	d.Set("key", output.Key)
+	d.Set("key_value_store_arn", kvsARN)
+	d.Set("total_size_in_bytes", output.TotalSizeInBytes)
+	d.Set("value", output.Value)
+
+	return diags
+}
+
+func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN, key, err := keyParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := putKey(ctx, conn, kvsARN, key, d.Get("value").(string)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating CloudFront KeyValueStore Key (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN, key, err := keyParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	conns.GlobalMutexKV.Lock(kvsARN)
+	defer conns.GlobalMutexKV.Unlock(kvsARN)
+
+	log.Printf("[DEBUG] Deleting CloudFront KeyValueStore Key: %s", d.Id())
+	_, err = tfresource.RetryWhenIsA[*types.ConflictException](ctx, keyWriteTimeout, func() (interface{}, error) {
+		etag, err := findETagByARN(ctx, conn, kvsARN)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return conn.DeleteKey(ctx, &cloudfrontkeyvaluestore.DeleteKeyInput{
+			IfMatch: etag,
+			Key:     aws.String(key),
+			KvsARN:  aws.String(kvsARN),
+		})
+	})
+
+	if tfresource.NotFound(err) || errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudFront KeyValueStore Key (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// putKey creates or updates a key, serializing writes to the same key value store.
+func putKey(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN, key, value string) error {
+	conns.GlobalMutexKV.Lock(kvsARN)
+	defer conns.GlobalMutexKV.Unlock(kvsARN)
+
+	_, err := tfresource.RetryWhenIsA[*types.ConflictException](ctx, keyWriteTimeout, func() (interface{}, error) {
+		etag, err := findETagByARN(ctx, conn, kvsARN)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return conn.PutKey(ctx, &cloudfrontkeyvaluestore.PutKeyInput{
+			IfMatch: etag,
+			Key:     aws.String(key),
+			KvsARN:  aws.String(kvsARN),
+			Value:   aws.String(value),
+		})
+	})
+
+	return err
+}
+
+const keyResourceIDSeparator = ","
+
+func keyCreateResourceID(kvsARN, key string) string {
+	parts := []string{kvsARN, key}
+	id := strings.Join(parts, keyResourceIDSeparator)
+
+	return id
+}
+
+func keyParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, keyResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected KEY-VALUE-STORE-ARN%[2]sKEY", id, keyResourceIDSeparator)
+}
+
+func findETagByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, arn string) (*string, error) {
+	input := &cloudfrontkeyvaluestore.DescribeKeyValueStoreInput{
+		KvsARN: aws.String(arn),
+	}
+
+	output, err := conn.DescribeKeyValueStore(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ETag == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ETag, nil
+}
+
+func findKeyByTwoPartKey(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN, key string) (*cloudfrontkeyvaluestore.GetKeyOutput, error) {
+	input := &cloudfrontkeyvaluestore.GetKeyInput{
+		Key:    aws.String(key),
+		KvsARN: aws.String(kvsARN),
+	}
+
+	output, err := conn.GetKey(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Key == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -93,6 +94,12 @@ func ResourcePipeline() *schema.Resource {
 					},
 				},
 			},
+			"execution_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      codepipeline.ExecutionModeSuperseded,
+				ValidateFunc: validation.StringInSlice(codepipeline.ExecutionMode_Values(), false),
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -102,6 +109,12 @@ func ResourcePipeline() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9.@\-_]+`), ""),
 				),
 			},
+			"pipeline_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      codepipeline.PipelineTypeV1,
+				ValidateFunc: validation.StringInSlice(codepipeline.PipelineType_Values(), false),
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -209,9 +222,135 @@ func ResourcePipeline() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"git_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pull_request": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"branches": gitFilterCriteriaSchema(),
+												"events": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 1,
+													MaxItems: 3,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringInSlice(codepipeline.GitPullRequestEventType_Values(), false),
+													},
+												},
+												"file_paths": gitFilterCriteriaSchema(),
+											},
+										},
+									},
+									"push": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"branches":   gitFilterCriteriaSchema(),
+												"file_paths": gitFilterCriteriaSchema(),
+												"tags":       gitFilterCriteriaSchema(),
+											},
+										},
+									},
+									"source_action_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 100),
+											validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9.@\-_]+`), ""),
+										),
+									},
+								},
+							},
+						},
+						"provider_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(codepipeline.PipelineTriggerProviderType_Values(), false),
+						},
+					},
+				},
+			},
+			"variable": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 128),
+								validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9@\-_]+`), ""),
+							),
+						},
+					},
+				},
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			pipelineCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func gitFilterCriteriaSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"excludes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MinItems: 1,
+					MaxItems: 8,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringLenBetween(1, 255),
+					},
+				},
+				"includes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MinItems: 1,
+					MaxItems: 8,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringLenBetween(1, 255),
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -277,8 +416,25 @@ func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	arn := aws.StringValue(metadata.PipelineArn)
 	d.Set("arn", arn)
+	// Pipelines created before the introduction of pipeline types and execution modes don't report them.
+	if v := aws.StringValue(pipeline.ExecutionMode); v != "" {
+		d.Set("execution_mode", v)
+	} else {
+		d.Set("execution_mode", codepipeline.ExecutionModeSuperseded)
+	}
 	d.Set("name", pipeline.Name)
+	if v := aws.StringValue(pipeline.PipelineType); v != "" {
+		d.Set("pipeline_type", v)
+	} else {
+		d.Set("pipeline_type", codepipeline.PipelineTypeV1)
+	}
 	d.Set("role_arn", pipeline.RoleArn)
+	if err := d.Set("trigger", flattenTriggerDeclarations(pipeline.Triggers)); err != nil {
+		return diag.Errorf("setting trigger: %s", err)
+	}
+	if err := d.Set("variable", flattenVariableDeclarations(pipeline.Variables)); err != nil {
+		return diag.Errorf("setting variable: %s", err)
+	}
 
 	return nil
 }
@@ -349,6 +505,25 @@ func FindPipelineByName(ctx context.Context, conn *codepipeline.CodePipeline, na
 	return output, nil
 }
 
+// pipelineCustomizeDiff rejects V2-only settings on V1 pipelines at plan time.
+func pipelineCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if pipelineType := d.Get("pipeline_type").(string); pipelineType == codepipeline.PipelineTypeV1 {
+		if v, ok := d.GetOk("trigger"); ok && len(v.([]interface{})) > 0 {
+			return fmt.Errorf("trigger can only be set for %s pipelines", codepipeline.PipelineTypeV2)
+		}
+
+		if v, ok := d.GetOk("variable"); ok && len(v.([]interface{})) > 0 {
+			return fmt.Errorf("variable can only be set for %s pipelines", codepipeline.PipelineTypeV2)
+		}
+
+		if v := d.Get("execution_mode").(string); v != codepipeline.ExecutionModeSuperseded {
+			return fmt.Errorf("execution_mode %s can only be set for %s pipelines", v, codepipeline.PipelineTypeV2)
+		}
+	}
+
+	return nil
+}
+
 func pipelineValidateActionProvider(i interface{}, path cty.Path) diag.Diagnostics {
 	v, ok := i.(string)
 	if !ok {
@@ -422,10 +597,18 @@ func expandPipelineDeclaration(d *schema.ResourceData) (*codepipeline.PipelineDe
 		}
 	}
 
+	if v, ok := d.GetOk("execution_mode"); ok {
+		apiObject.ExecutionMode = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("name"); ok {
 		apiObject.Name = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("pipeline_type"); ok {
+		apiObject.PipelineType = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("role_arn"); ok {
 		apiObject.RoleArn = aws.String(v.(string))
 	}
@@ -434,6 +617,14 @@ func expandPipelineDeclaration(d *schema.ResourceData) (*codepipeline.PipelineDe
 		apiObject.Stages = expandStageDeclarations(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("trigger"); ok && len(v.([]interface{})) > 0 {
+		apiObject.Triggers = expandTriggerDeclarations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("variable"); ok && len(v.([]interface{})) > 0 {
+		apiObject.Variables = expandVariableDeclarations(v.([]interface{}))
+	}
+
 	return apiObject, nil
 }
 
@@ -687,6 +878,242 @@ func expandOutputArtifacts(tfList []interface{}) []*codepipeline.OutputArtifact
 	return apiObjects
 }
 
+func expandVariableDeclaration(tfMap map[string]interface{}) *codepipeline.PipelineVariableDeclaration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &codepipeline.PipelineVariableDeclaration{}
+
+	if v, ok := tfMap["default_value"].(string); ok && v != "" {
+		apiObject.DefaultValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandVariableDeclarations(tfList []interface{}) []*codepipeline.PipelineVariableDeclaration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*codepipeline.PipelineVariableDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandVariableDeclaration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTriggerDeclaration(tfMap map[string]interface{}) *codepipeline.PipelineTriggerDeclaration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &codepipeline.PipelineTriggerDeclaration{}
+
+	if v, ok := tfMap["git_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.GitConfiguration = expandGitConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["provider_type"].(string); ok && v != "" {
+		apiObject.ProviderType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTriggerDeclarations(tfList []interface{}) []*codepipeline.PipelineTriggerDeclaration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*codepipeline.PipelineTriggerDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandTriggerDeclaration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGitConfiguration(tfMap map[string]interface{}) *codepipeline.GitConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &codepipeline.GitConfiguration{}
+
+	if v, ok := tfMap["pull_request"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PullRequest = expandGitPullRequestFilters(v)
+	}
+
+	if v, ok := tfMap["push"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Push = expandGitPushFilters(v)
+	}
+
+	if v, ok := tfMap["source_action_name"].(string); ok && v != "" {
+		apiObject.SourceActionName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGitPullRequestFilters(tfList []interface{}) []*codepipeline.GitPullRequestFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*codepipeline.GitPullRequestFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codepipeline.GitPullRequestFilter{}
+
+		if v, ok := tfMap["branches"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Branches = expandGitBranchFilterCriteria(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["events"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Events = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["file_paths"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.FilePaths = expandGitFilePathFilterCriteria(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGitPushFilters(tfList []interface{}) []*codepipeline.GitPushFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*codepipeline.GitPushFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codepipeline.GitPushFilter{}
+
+		if v, ok := tfMap["branches"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Branches = expandGitBranchFilterCriteria(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["file_paths"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.FilePaths = expandGitFilePathFilterCriteria(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["tags"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Tags = expandGitTagFilterCriteria(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGitBranchFilterCriteria(tfMap map[string]interface{}) *codepipeline.GitBranchFilterCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &codepipeline.GitBranchFilterCriteria{}
+
+	if v, ok := tfMap["excludes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Excludes = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["includes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Includes = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func expandGitFilePathFilterCriteria(tfMap map[string]interface{}) *codepipeline.GitFilePathFilterCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &codepipeline.GitFilePathFilterCriteria{}
+
+	if v, ok := tfMap["excludes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Excludes = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["includes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Includes = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func expandGitTagFilterCriteria(tfMap map[string]interface{}) *codepipeline.GitTagFilterCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &codepipeline.GitTagFilterCriteria{}
+
+	if v, ok := tfMap["excludes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Excludes = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["includes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Includes = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
 func flattenArtifactStore(apiObject *codepipeline.ArtifactStore) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -909,3 +1336,179 @@ func flattenOutputArtifacts(apiObjects []*codepipeline.OutputArtifact) []string
 
 	return aws.StringValueSlice(tfList)
 }
+
+func flattenVariableDeclaration(apiObject *codepipeline.PipelineVariableDeclaration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DefaultValue; v != nil {
+		tfMap["default_value"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Description; v != nil {
+		tfMap["description"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenVariableDeclarations(apiObjects []*codepipeline.PipelineVariableDeclaration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenVariableDeclaration(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenTriggerDeclaration(apiObject *codepipeline.PipelineTriggerDeclaration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.GitConfiguration; v != nil {
+		tfMap["git_configuration"] = []interface{}{flattenGitConfiguration(v)}
+	}
+
+	if v := apiObject.ProviderType; v != nil {
+		tfMap["provider_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenTriggerDeclarations(apiObjects []*codepipeline.PipelineTriggerDeclaration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTriggerDeclaration(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenGitConfiguration(apiObject *codepipeline.GitConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PullRequest; v != nil {
+		tfMap["pull_request"] = flattenGitPullRequestFilters(v)
+	}
+
+	if v := apiObject.Push; v != nil {
+		tfMap["push"] = flattenGitPushFilters(v)
+	}
+
+	if v := apiObject.SourceActionName; v != nil {
+		tfMap["source_action_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenGitPullRequestFilters(apiObjects []*codepipeline.GitPullRequestFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Branches; v != nil {
+			tfMap["branches"] = []interface{}{flattenGitFilterCriteria(v.Excludes, v.Includes)}
+		}
+
+		if v := apiObject.Events; v != nil {
+			tfMap["events"] = aws.StringValueSlice(v)
+		}
+
+		if v := apiObject.FilePaths; v != nil {
+			tfMap["file_paths"] = []interface{}{flattenGitFilterCriteria(v.Excludes, v.Includes)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenGitPushFilters(apiObjects []*codepipeline.GitPushFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Branches; v != nil {
+			tfMap["branches"] = []interface{}{flattenGitFilterCriteria(v.Excludes, v.Includes)}
+		}
+
+		if v := apiObject.FilePaths; v != nil {
+			tfMap["file_paths"] = []interface{}{flattenGitFilterCriteria(v.Excludes, v.Includes)}
+		}
+
+		if v := apiObject.Tags; v != nil {
+			tfMap["tags"] = []interface{}{flattenGitFilterCriteria(v.Excludes, v.Includes)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenGitFilterCriteria(excludes, includes []*string) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if excludes != nil {
+		tfMap["excludes"] = aws.StringValueSlice(excludes)
+	}
+
+	if includes != nil {
+		tfMap["includes"] = aws.StringValueSlice(includes)
+	}
+
+	return tfMap
+}
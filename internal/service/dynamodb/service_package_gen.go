@@ -50,6 +50,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceKinesisStreamingDestination,
 			TypeName: "aws_dynamodb_kinesis_streaming_destination",
 		},
+		{
+			Factory:  ResourceResourcePolicy,
+			TypeName: "aws_dynamodb_resource_policy",
+			Name:     "Resource Policy",
+		},
 		{
 			Factory:  ResourceTable,
 			TypeName: "aws_dynamodb_table",
@@ -58,6 +63,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceTableExport,
+			TypeName: "aws_dynamodb_table_export",
+			Name:     "Table Export",
+		},
 		{
 			Factory:  ResourceTableItem,
 			TypeName: "aws_dynamodb_table_item",
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -64,6 +65,9 @@ func ResourceTable() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 				return validateTableAttributes(diff)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return validateImportTable(diff)
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 				if diff.Id() != "" && diff.HasChange("server_side_encryption") {
 					o, n := diff.GetChange("server_side_encryption")
@@ -198,6 +202,91 @@ func ResourceTable() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"import_table": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"restore_source_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_compression_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputCompressionType_Values(), false),
+						},
+						"input_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputFormat_Values(), false),
+						},
+						"input_format_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"csv": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delimiter": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice([]string{",", "\t", ":", ";", "|", " "}, false),
+												},
+												"header_list": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringLenBetween(1, 65536),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_bucket_source": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"key_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"local_secondary_index": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -486,6 +575,76 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		if err != nil {
 			return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
 		}
+	} else if v, ok := d.GetOk("import_table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		billingMode := d.Get("billing_mode").(string)
+		input := expandImportTable(v.([]interface{})[0].(map[string]interface{}))
+
+		tcp := &dynamodb.TableCreationParameters{
+			BillingMode: aws.String(billingMode),
+			KeySchema:   expandKeySchema(keySchemaMap),
+			TableName:   aws.String(tableName),
+		}
+
+		capacityMap := map[string]interface{}{
+			"write_capacity": d.Get("write_capacity"),
+			"read_capacity":  d.Get("read_capacity"),
+		}
+
+		tcp.ProvisionedThroughput = expandProvisionedThroughput(capacityMap, billingMode)
+
+		if v, ok := d.GetOk("attribute"); ok {
+			aSet := v.(*schema.Set)
+			tcp.AttributeDefinitions = expandAttributes(aSet.List())
+		}
+
+		if v, ok := d.GetOk("global_secondary_index"); ok {
+			globalSecondaryIndexes := []*dynamodb.GlobalSecondaryIndex{}
+			gsiSet := v.(*schema.Set)
+
+			for _, gsiObject := range gsiSet.List() {
+				gsi := gsiObject.(map[string]interface{})
+				if err := validateGSIProvisionedThroughput(gsi, billingMode); err != nil {
+					return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
+				}
+
+				gsiObject := expandGlobalSecondaryIndex(gsi, billingMode)
+				globalSecondaryIndexes = append(globalSecondaryIndexes, gsiObject)
+			}
+			tcp.GlobalSecondaryIndexes = globalSecondaryIndexes
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			tcp.SSESpecification = expandEncryptAtRestOptions(v.([]interface{}))
+		}
+
+		input.ClientToken = aws.String(id.UniqueId())
+		input.TableCreationParameters = tcp
+
+		importTableOutput, err := tfresource.RetryWhen(ctx, createTableTimeout, func() (interface{}, error) {
+			return conn.ImportTableWithContext(ctx, input)
+		}, func(err error) (bool, error) {
+			if tfawserr.ErrCodeEquals(err, "ThrottlingException") {
+				return true, err
+			}
+			if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "can be created, updated, or deleted simultaneously") {
+				return true, err
+			}
+			if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "indexed tables that can be created simultaneously") {
+				return true, err
+			}
+
+			return false, err
+		})
+
+		if err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
+		}
+
+		importARN := aws.StringValue(importTableOutput.(*dynamodb.ImportTableOutput).ImportTableDescription.ImportArn)
+
+		if _, err = waitImportTableCompleted(ctx, conn, importARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, tableName, fmt.Errorf("import (%s): %w", importARN, err))
+		}
 	} else {
 		input := &dynamodb.CreateTableInput{
 			BillingMode: aws.String(d.Get("billing_mode").(string)),
@@ -577,6 +736,15 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return create.DiagError(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, d.Id(), err)
 	}
 
+	// ImportTable doesn't support tags, so apply them once the table exists.
+	if _, ok := d.GetOk("import_table"); ok {
+		if tags := getTagsIn(ctx); len(tags) > 0 {
+			if err := updateTags(ctx, conn, aws.StringValue(output.TableArn), nil, KeyValueTags(ctx, tags)); err != nil {
+				return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Id(), fmt.Errorf("adding tags: %w", err))
+			}
+		}
+	}
+
 	if v, ok := d.GetOk("global_secondary_index"); ok {
 		gsiSet := v.(*schema.Set)
 
@@ -2180,6 +2348,25 @@ func validateTableAttributes(d *schema.ResourceDiff) error {
 	return err.ErrorOrNil()
 }
 
+func validateImportTable(d *schema.ResourceDiff) error {
+	v, ok := d.GetOk("import_table")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	inputFormat := tfMap["input_format"].(string)
+
+	if v, ok := tfMap["input_format_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["csv"].([]interface{}); ok && len(v) > 0 && inputFormat != dynamodb.InputFormatCsv {
+			return fmt.Errorf("import_table.0.input_format_options.0.csv can only be set when input_format is %q", dynamodb.InputFormatCsv)
+		}
+	}
+
+	return nil
+}
+
 func validateGSIProvisionedThroughput(data map[string]interface{}, billingMode string) error {
 	// if billing mode is PAY_PER_REQUEST, don't need to validate the throughput settings
 	if billingMode == dynamodb.BillingModePayPerRequest {
@@ -2222,3 +2409,74 @@ func validateProvisionedThroughputField(diff *schema.ResourceDiff, key string) e
 	}
 	return nil
 }
+
+func expandImportTable(tfMap map[string]interface{}) *dynamodb.ImportTableInput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dynamodb.ImportTableInput{}
+
+	if v, ok := tfMap["input_compression_type"].(string); ok && v != "" {
+		apiObject.InputCompressionType = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_format"].(string); ok && v != "" {
+		apiObject.InputFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_format_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InputFormatOptions = expandInputFormatOptions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_bucket_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3BucketSource = expandS3BucketSource(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandInputFormatOptions(tfMap map[string]interface{}) *dynamodb.InputFormatOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dynamodb.InputFormatOptions{}
+
+	if v, ok := tfMap["csv"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Csv = &dynamodb.CsvOptions{}
+
+		if v, ok := tfMap["delimiter"].(string); ok && v != "" {
+			apiObject.Csv.Delimiter = aws.String(v)
+		}
+
+		if v, ok := tfMap["header_list"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Csv.HeaderList = flex.ExpandStringList(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3BucketSource(tfMap map[string]interface{}) *dynamodb.S3BucketSource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dynamodb.S3BucketSource{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.S3Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["bucket_owner"].(string); ok && v != "" {
+		apiObject.S3BucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.S3KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_dynamodb_table_export", name="Table Export")
+func ResourceTableExport() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableExportCreate,
+		ReadWithoutTimeout:   resourceTableExportRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: resourceTableExportCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billed_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dynamodb.ExportFormatDynamodbJson,
+				ValidateFunc: validation.StringInSlice(dynamodb.ExportFormat_Values(), false),
+			},
+			"export_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"export_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(dynamodb.ExportType_Values(), false),
+			},
+			"incremental_export_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"export_from_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"export_to_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"export_view_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.ExportViewType_Values(), false),
+						},
+					},
+				},
+			},
+			"item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"manifest_files_s3_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"s3_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"s3_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"s3_sse_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(dynamodb.S3SseAlgorithm_Values(), false),
+			},
+			"s3_sse_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceTableExportCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("incremental_export_specification")
+	hasSpecification := ok && len(v.([]interface{})) > 0
+
+	switch exportType := d.Get("export_type").(string); exportType {
+	case dynamodb.ExportTypeIncrementalExport:
+		if !hasSpecification {
+			return fmt.Errorf("incremental_export_specification must be set when export_type is %q", exportType)
+		}
+	default:
+		if hasSpecification {
+			return fmt.Errorf("incremental_export_specification can only be set when export_type is %q", dynamodb.ExportTypeIncrementalExport)
+		}
+	}
+
+	return nil
+}
+
+func resourceTableExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableARN := d.Get("table_arn").(string)
+	input := &dynamodb.ExportTableToPointInTimeInput{
+		ClientToken:  aws.String(id.UniqueId()),
+		ExportFormat: aws.String(d.Get("export_format").(string)),
+		S3Bucket:     aws.String(d.Get("s3_bucket").(string)),
+		TableArn:     aws.String(tableARN),
+	}
+
+	if v, ok := d.GetOk("export_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExportTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("export_type"); ok {
+		input.ExportType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("incremental_export_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IncrementalExportSpecification = expandIncrementalExportSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("s3_bucket_owner"); ok {
+		input.S3BucketOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_prefix"); ok {
+		input.S3Prefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_sse_algorithm"); ok {
+		input.S3SseAlgorithm = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_sse_kms_key_id"); ok {
+		input.S3SseKmsKeyId = aws.String(v.(string))
+	}
+
+	output, err := conn.ExportTableToPointInTimeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Export: %s", tableARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.ExportDescription.ExportArn))
+
+	if _, err := waitTableExportCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Table Export (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTableExportRead(ctx, d, meta)...)
+}
+
+func resourceTableExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	desc, err := FindTableExportByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table Export (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Export (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, desc.ExportArn)
+	d.Set("billed_size_in_bytes", desc.BilledSizeBytes)
+	if desc.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(desc.EndTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+	d.Set("export_format", desc.ExportFormat)
+	d.Set("export_status", desc.ExportStatus)
+	if desc.ExportTime != nil {
+		d.Set("export_time", aws.TimeValue(desc.ExportTime).Format(time.RFC3339))
+	} else {
+		d.Set("export_time", nil)
+	}
+	d.Set("export_type", desc.ExportType)
+	if err := d.Set("incremental_export_specification", flattenIncrementalExportSpecification(desc.IncrementalExportSpecification)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting incremental_export_specification: %s", err)
+	}
+	d.Set("item_count", desc.ItemCount)
+	d.Set("manifest_files_s3_key", desc.ExportManifest)
+	d.Set("s3_bucket", desc.S3Bucket)
+	d.Set("s3_bucket_owner", desc.S3BucketOwner)
+	d.Set("s3_prefix", desc.S3Prefix)
+	d.Set("s3_sse_algorithm", desc.S3SseAlgorithm)
+	d.Set("s3_sse_kms_key_id", desc.S3SseKmsKeyId)
+	if desc.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(desc.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("table_arn", desc.TableArn)
+
+	return diags
+}
+
+func expandIncrementalExportSpecification(tfMap map[string]interface{}) *dynamodb.IncrementalExportSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dynamodb.IncrementalExportSpecification{}
+
+	if v, ok := tfMap["export_from_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.ExportFromTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["export_to_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.ExportToTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["export_view_type"].(string); ok && v != "" {
+		apiObject.ExportViewType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenIncrementalExportSpecification(apiObject *dynamodb.IncrementalExportSpecification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"export_view_type": aws.StringValue(apiObject.ExportViewType),
+	}
+
+	if v := apiObject.ExportFromTime; v != nil {
+		tfMap["export_from_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.ExportToTime; v != nil {
+		tfMap["export_to_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -2546,6 +2546,68 @@ func TestAccDynamoDBTable_backup_overrideEncryption(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_importTable(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf dynamodb.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_importTable(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "import_table.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_compression_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format_options.0.csv.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format_options.0.csv.0.delimiter", ","),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.s3_bucket_source.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "import_table.0.s3_bucket_source.0.bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.s3_bucket_source.0.key_prefix", "import"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"import_table"},
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_importTableInputFormatOptionsValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTableConfig_importTableInputFormat(rName, "DYNAMODB_JSON", ","),
+				ExpectError: regexp.MustCompile(`csv can only be set when input_format is "CSV"`),
+			},
+			{
+				Config:      testAccTableConfig_importTableInputFormat(rName, "CSV", "#"),
+				ExpectError: regexp.MustCompile(`expected import_table.0.input_format_options.0.csv.0.delimiter to be one of`),
+			},
+		},
+	})
+}
+
 func testAccCheckTableDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
@@ -4409,3 +4471,83 @@ resource "aws_dynamodb_table" "test" {
 }
 `, rName)
 }
+
+func testAccTableConfig_importTable(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "import/data.csv"
+  content = <<CSV
+id,name
+1,one
+2,two
+CSV
+}
+
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  import_table {
+    input_compression_type = "NONE"
+    input_format           = "CSV"
+
+    input_format_options {
+      csv {
+        delimiter = ","
+      }
+    }
+
+    s3_bucket_source {
+      bucket     = aws_s3_object.test.bucket
+      key_prefix = "import"
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccTableConfig_importTableInputFormat(rName, inputFormat, delimiter string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  import_table {
+    input_format = %[2]q
+
+    input_format_options {
+      csv {
+        delimiter   = %[3]q
+        header_list = ["id", "name"]
+      }
+    }
+
+    s3_bucket_source {
+      bucket = %[1]q
+    }
+  }
+}
+`, rName, inputFormat, delimiter)
+}
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -24,6 +26,7 @@ const (
 	deleteTableTimeout                         = 10 * time.Minute
 	pitrUpdateTimeout                          = 30 * time.Second
 	ttlUpdateTimeout                           = 30 * time.Second
+	propagationTimeout                         = 2 * time.Minute
 )
 
 func maxDuration(a, b time.Duration) time.Duration {
@@ -280,3 +283,45 @@ func waitContributorInsightsDeleted(ctx context.Context, conn *dynamodb.DynamoDB
 
 	return err
 }
+
+func waitTableExportCreated(ctx context.Context, conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ExportDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{dynamodb.ExportStatusInProgress},
+		Target:  []string{dynamodb.ExportStatusCompleted},
+		Timeout: timeout,
+		Refresh: statusTableExport(ctx, conn, arn),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.ExportDescription); ok {
+		if code, message := aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage); code != "" || message != "" {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", code, message))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitImportTableCompleted(ctx context.Context, conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ImportTableDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{dynamodb.ImportStatusInProgress},
+		Target:  []string{dynamodb.ImportStatusCompleted},
+		Timeout: maxDuration(createTableTimeout, timeout),
+		Refresh: statusImport(ctx, conn, arn),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.ImportTableDescription); ok {
+		if code, message := aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage); code != "" || message != "" {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", code, message))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
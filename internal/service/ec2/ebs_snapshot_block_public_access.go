@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKResource("aws_ebs_snapshot_block_public_access", name="Snapshot Block Public Access")
+func ResourceSnapshotBlockPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSnapshotBlockPublicAccessPut,
+		ReadWithoutTimeout:   resourceSnapshotBlockPublicAccessRead,
+		UpdateWithoutTimeout: resourceSnapshotBlockPublicAccessPut,
+		DeleteWithoutTimeout: resourceSnapshotBlockPublicAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(ec2.SnapshotBlockPublicAccessState_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceSnapshotBlockPublicAccessPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	state := d.Get("state").(string)
+
+	if err := setSnapshotBlockPublicAccessState(ctx, conn, state); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting EBS Snapshot Block Public Access (%s): %s", state, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return append(diags, resourceSnapshotBlockPublicAccessRead(ctx, d, meta)...)
+}
+
+func resourceSnapshotBlockPublicAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	state, err := FindSnapshotBlockPublicAccessState(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot Block Public Access: %s", err)
+	}
+
+	d.Set("state", state)
+
+	return diags
+}
+
+func resourceSnapshotBlockPublicAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	// Removing the resource restores the AWS default of unblocked public sharing.
+	if err := setSnapshotBlockPublicAccessState(ctx, conn, ec2.SnapshotBlockPublicAccessStateUnblocked); err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling EBS Snapshot Block Public Access: %s", err)
+	}
+
+	return diags
+}
+
+func setSnapshotBlockPublicAccessState(ctx context.Context, conn *ec2.EC2, state string) error {
+	var err error
+
+	if state == ec2.SnapshotBlockPublicAccessStateUnblocked {
+		_, err = conn.DisableSnapshotBlockPublicAccessWithContext(ctx, &ec2.DisableSnapshotBlockPublicAccessInput{})
+	} else {
+		_, err = conn.EnableSnapshotBlockPublicAccessWithContext(ctx, &ec2.EnableSnapshotBlockPublicAccessInput{
+			State: aws.String(state),
+		})
+	}
+
+	return err
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2EBSSnapshotBlockPublicAccess_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		"basic": testAccEBSSnapshotBlockPublicAccess_basic,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccEBSSnapshotBlockPublicAccess_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ebs_snapshot_block_public_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSSnapshotBlockPublicAccessDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotBlockPublicAccessConfig_basic("block-all-sharing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSSnapshotBlockPublicAccessState(ctx, "block-all-sharing"),
+					resource.TestCheckResourceAttr(resourceName, "state", "block-all-sharing"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEBSSnapshotBlockPublicAccessConfig_basic("block-new-sharing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSSnapshotBlockPublicAccessState(ctx, "block-new-sharing"),
+					resource.TestCheckResourceAttr(resourceName, "state", "block-new-sharing"),
+				),
+			},
+			{
+				Config: testAccEBSSnapshotBlockPublicAccessConfig_basic("unblocked"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSSnapshotBlockPublicAccessState(ctx, "unblocked"),
+					resource.TestCheckResourceAttr(resourceName, "state", "unblocked"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEBSSnapshotBlockPublicAccessDestroy(ctx context.Context) resource.TestCheckFunc {
+	return testAccCheckEBSSnapshotBlockPublicAccessState(ctx, ec2.SnapshotBlockPublicAccessStateUnblocked)
+}
+
+func testAccCheckEBSSnapshotBlockPublicAccessState(ctx context.Context, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		state, err := tfec2.FindSnapshotBlockPublicAccessState(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if state != expected {
+			return fmt.Errorf("EBS Snapshot Block Public Access state is %s, expected %s", state, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccEBSSnapshotBlockPublicAccessConfig_basic(state string) string {
+	return fmt.Sprintf(`
+resource "aws_ebs_snapshot_block_public_access" "test" {
+  state = %[1]q
+}
+`, state)
+}
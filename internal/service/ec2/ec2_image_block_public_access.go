@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKResource("aws_ec2_image_block_public_access", name="Image Block Public Access")
+func ResourceImageBlockPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceImageBlockPublicAccessPut,
+		ReadWithoutTimeout:   resourceImageBlockPublicAccessRead,
+		UpdateWithoutTimeout: resourceImageBlockPublicAccessPut,
+		DeleteWithoutTimeout: resourceImageBlockPublicAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing,
+					ec2.ImageBlockPublicAccessDisabledStateUnblocked,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceImageBlockPublicAccessPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	state := d.Get("state").(string)
+
+	if err := setImageBlockPublicAccessState(ctx, conn, state); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting EC2 Image Block Public Access (%s): %s", state, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if err := WaitImageBlockPublicAccessState(ctx, conn, state, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Image Block Public Access state (%s): %s", state, err)
+	}
+
+	return append(diags, resourceImageBlockPublicAccessRead(ctx, d, meta)...)
+}
+
+func resourceImageBlockPublicAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	state, err := FindImageBlockPublicAccessState(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Image Block Public Access: %s", err)
+	}
+
+	d.Set("state", state)
+
+	return diags
+}
+
+func resourceImageBlockPublicAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	// Removing the resource restores the AWS default of unblocked public sharing.
+	state := ec2.ImageBlockPublicAccessDisabledStateUnblocked
+
+	if err := setImageBlockPublicAccessState(ctx, conn, state); err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling EC2 Image Block Public Access: %s", err)
+	}
+
+	if err := WaitImageBlockPublicAccessState(ctx, conn, state, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Image Block Public Access state (%s): %s", state, err)
+	}
+
+	return diags
+}
+
+func setImageBlockPublicAccessState(ctx context.Context, conn *ec2.EC2, state string) error {
+	var err error
+
+	if state == ec2.ImageBlockPublicAccessDisabledStateUnblocked {
+		_, err = conn.DisableImageBlockPublicAccessWithContext(ctx, &ec2.DisableImageBlockPublicAccessInput{})
+	} else {
+		_, err = conn.EnableImageBlockPublicAccessWithContext(ctx, &ec2.EnableImageBlockPublicAccessInput{
+			ImageBlockPublicAccessState: aws.String(state),
+		})
+	}
+
+	return err
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+const (
+	// An HTTP PUT response hop limit of -1 indicates no preference.
+	instanceMetadataDefaultsHTTPPutResponseHopLimitNoPreference = -1
+)
+
+// @SDKResource("aws_ec2_instance_metadata_defaults", name="Instance Metadata Defaults")
+func ResourceInstanceMetadataDefaults() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceMetadataDefaultsPut,
+		ReadWithoutTimeout:   resourceInstanceMetadataDefaultsRead,
+		UpdateWithoutTimeout: resourceInstanceMetadataDefaultsPut,
+		DeleteWithoutTimeout: resourceInstanceMetadataDefaultsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"http_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.DefaultInstanceMetadataEndpointStateNoPreference,
+				ValidateFunc: validation.StringInSlice(ec2.DefaultInstanceMetadataEndpointState_Values(), false),
+			},
+			"http_put_response_hop_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      instanceMetadataDefaultsHTTPPutResponseHopLimitNoPreference,
+				ValidateFunc: validation.IntBetween(-1, 64),
+			},
+			"http_tokens": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.MetadataDefaultHttpTokensStateNoPreference,
+				ValidateFunc: validation.StringInSlice(ec2.MetadataDefaultHttpTokensState_Values(), false),
+			},
+			"instance_metadata_tags": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.DefaultInstanceMetadataTagsStateNoPreference,
+				ValidateFunc: validation.StringInSlice(ec2.DefaultInstanceMetadataTagsState_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceInstanceMetadataDefaultsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.ModifyInstanceMetadataDefaultsInput{
+		HttpEndpoint:            aws.String(d.Get("http_endpoint").(string)),
+		HttpPutResponseHopLimit: aws.Int64(int64(d.Get("http_put_response_hop_limit").(int))),
+		HttpTokens:              aws.String(d.Get("http_tokens").(string)),
+		InstanceMetadataTags:    aws.String(d.Get("instance_metadata_tags").(string)),
+	}
+
+	_, err := conn.ModifyInstanceMetadataDefaultsWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting EC2 Instance Metadata Defaults: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return append(diags, resourceInstanceMetadataDefaultsRead(ctx, d, meta)...)
+}
+
+func resourceInstanceMetadataDefaultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	output, err := FindInstanceMetadataDefaults(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance Metadata Defaults: %s", err)
+	}
+
+	// Unset values are returned as nil and correspond to "no-preference".
+	if v := output.HttpEndpoint; v != nil {
+		d.Set("http_endpoint", v)
+	} else {
+		d.Set("http_endpoint", ec2.DefaultInstanceMetadataEndpointStateNoPreference)
+	}
+	if v := output.HttpPutResponseHopLimit; v != nil {
+		d.Set("http_put_response_hop_limit", v)
+	} else {
+		d.Set("http_put_response_hop_limit", instanceMetadataDefaultsHTTPPutResponseHopLimitNoPreference)
+	}
+	if v := output.HttpTokens; v != nil {
+		d.Set("http_tokens", v)
+	} else {
+		d.Set("http_tokens", ec2.MetadataDefaultHttpTokensStateNoPreference)
+	}
+	if v := output.InstanceMetadataTags; v != nil {
+		d.Set("instance_metadata_tags", v)
+	} else {
+		d.Set("instance_metadata_tags", ec2.DefaultInstanceMetadataTagsStateNoPreference)
+	}
+
+	return diags
+}
+
+func resourceInstanceMetadataDefaultsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	// Removing the resource clears all account-level defaults.
+	_, err := conn.ModifyInstanceMetadataDefaultsWithContext(ctx, &ec2.ModifyInstanceMetadataDefaultsInput{
+		HttpEndpoint:            aws.String(ec2.DefaultInstanceMetadataEndpointStateNoPreference),
+		HttpPutResponseHopLimit: aws.Int64(instanceMetadataDefaultsHTTPPutResponseHopLimitNoPreference),
+		HttpTokens:              aws.String(ec2.MetadataDefaultHttpTokensStateNoPreference),
+		InstanceMetadataTags:    aws.String(ec2.DefaultInstanceMetadataTagsStateNoPreference),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "resetting EC2 Instance Metadata Defaults: %s", err)
+	}
+
+	return diags
+}
@@ -9,6 +9,8 @@ var (
 	ResourceSecurityGroupEgressRule  = newResourceSecurityGroupEgressRule
 	ResourceSecurityGroupIngressRule = newResourceSecurityGroupIngressRule
 
-	UpdateTags   = updateTags
-	UpdateTagsV2 = updateTagsV2
+	FindVPCBlockPublicAccessExclusionByID = findVPCBlockPublicAccessExclusionByIDV2
+	FindVPCBlockPublicAccessOptions       = findVPCBlockPublicAccessOptionsV2
+	UpdateTags                            = updateTags
+	UpdateTagsV2                          = updateTagsV2
 )
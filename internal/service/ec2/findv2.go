@@ -287,3 +287,86 @@ func findIPAMPoolAllocationsV2(ctx context.Context, conn *ec2.Client, input *ec2
 
 	return output, nil
 }
+
+func findVPCBlockPublicAccessOptionsV2(ctx context.Context, conn *ec2.Client) (*awstypes.VpcBlockPublicAccessOptions, error) {
+	input := &ec2.DescribeVpcBlockPublicAccessOptionsInput{}
+
+	output, err := conn.DescribeVpcBlockPublicAccessOptions(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.VpcBlockPublicAccessOptions == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.VpcBlockPublicAccessOptions, nil
+}
+
+func findVPCBlockPublicAccessExclusionV2(ctx context.Context, conn *ec2.Client, input *ec2.DescribeVpcBlockPublicAccessExclusionsInput) (*awstypes.VpcBlockPublicAccessExclusion, error) {
+	output, err := findVPCBlockPublicAccessExclusionsV2(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findVPCBlockPublicAccessExclusionsV2(ctx context.Context, conn *ec2.Client, input *ec2.DescribeVpcBlockPublicAccessExclusionsInput) ([]awstypes.VpcBlockPublicAccessExclusion, error) {
+	var output []awstypes.VpcBlockPublicAccessExclusion
+
+	for {
+		page, err := conn.DescribeVpcBlockPublicAccessExclusions(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidVPCBlockPublicAccessExclusionIDNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.VpcBlockPublicAccessExclusions...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func findVPCBlockPublicAccessExclusionByIDV2(ctx context.Context, conn *ec2.Client, id string) (*awstypes.VpcBlockPublicAccessExclusion, error) {
+	input := &ec2.DescribeVpcBlockPublicAccessExclusionsInput{
+		ExclusionIds: []string{id},
+	}
+
+	output, err := findVPCBlockPublicAccessExclusionV2(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.VpcBlockPublicAccessExclusionStateDeleteComplete {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.ExclusionId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -60,3 +60,35 @@ func statusVPCAttributeValueV2(ctx context.Context, conn *ec2.Client, id string,
 		return attributeValue, strconv.FormatBool(attributeValue), nil
 	}
 }
+
+func statusVPCBlockPublicAccessOptionsStateV2(ctx context.Context, conn *ec2.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findVPCBlockPublicAccessOptionsV2(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func statusVPCBlockPublicAccessExclusionStateV2(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findVPCBlockPublicAccessExclusionByIDV2(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
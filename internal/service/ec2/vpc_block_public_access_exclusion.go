@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpc_block_public_access_exclusion", name="VPC Block Public Access Exclusion")
+// @Tags(identifierAttribute="id")
+func ResourceVPCBlockPublicAccessExclusion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCBlockPublicAccessExclusionCreate,
+		ReadWithoutTimeout:   resourceVPCBlockPublicAccessExclusionRead,
+		UpdateWithoutTimeout: resourceVPCBlockPublicAccessExclusionUpdate,
+		DeleteWithoutTimeout: resourceVPCBlockPublicAccessExclusionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"internet_gateway_exclusion_mode": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.InternetGatewayExclusionMode](),
+			},
+			"resource_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"subnet_id", "vpc_id"},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"subnet_id", "vpc_id"},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceVPCBlockPublicAccessExclusionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.CreateVpcBlockPublicAccessExclusionInput{
+		InternetGatewayExclusionMode: awstypes.InternetGatewayExclusionMode(d.Get("internet_gateway_exclusion_mode").(string)),
+		TagSpecifications:            getTagSpecificationsInV2(ctx, awstypes.ResourceTypeVpcBlockPublicAccessExclusion),
+	}
+
+	if v, ok := d.GetOk("subnet_id"); ok {
+		input.SubnetId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vpc_id"); ok {
+		input.VpcId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateVpcBlockPublicAccessExclusion(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating VPC Block Public Access Exclusion: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.VpcBlockPublicAccessExclusion.ExclusionId))
+
+	if _, err := waitVPCBlockPublicAccessExclusionCreatedV2(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Block Public Access Exclusion (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceVPCBlockPublicAccessExclusionRead(ctx, d, meta)...)
+}
+
+func resourceVPCBlockPublicAccessExclusionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	exclusion, err := findVPCBlockPublicAccessExclusionByIDV2(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Block Public Access Exclusion (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Block Public Access Exclusion (%s): %s", d.Id(), err)
+	}
+
+	d.Set("internet_gateway_exclusion_mode", exclusion.InternetGatewayExclusionMode)
+	d.Set("resource_arn", exclusion.ResourceArn)
+
+	// The exclusion applies to either a subnet or a VPC; the resource ARN identifies which.
+	resourceType, resourceID, err := vpcBlockPublicAccessExclusionParseResourceARN(aws.ToString(exclusion.ResourceArn))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Block Public Access Exclusion (%s): %s", d.Id(), err)
+	}
+
+	switch resourceType {
+	case "subnet":
+		d.Set("subnet_id", resourceID)
+		d.Set("vpc_id", nil)
+	case "vpc":
+		d.Set("subnet_id", nil)
+		d.Set("vpc_id", resourceID)
+	}
+
+	setTagsOutV2(ctx, exclusion.Tags)
+
+	return diags
+}
+
+func resourceVPCBlockPublicAccessExclusionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if d.HasChange("internet_gateway_exclusion_mode") {
+		input := &ec2.ModifyVpcBlockPublicAccessExclusionInput{
+			ExclusionId:                  aws.String(d.Id()),
+			InternetGatewayExclusionMode: awstypes.InternetGatewayExclusionMode(d.Get("internet_gateway_exclusion_mode").(string)),
+		}
+
+		_, err := conn.ModifyVpcBlockPublicAccessExclusion(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating VPC Block Public Access Exclusion (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitVPCBlockPublicAccessExclusionUpdatedV2(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for VPC Block Public Access Exclusion (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceVPCBlockPublicAccessExclusionRead(ctx, d, meta)...)
+}
+
+func resourceVPCBlockPublicAccessExclusionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	log.Printf("[INFO] Deleting VPC Block Public Access Exclusion: %s", d.Id())
+	_, err := conn.DeleteVpcBlockPublicAccessExclusion(ctx, &ec2.DeleteVpcBlockPublicAccessExclusionInput{
+		ExclusionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVPCBlockPublicAccessExclusionIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting VPC Block Public Access Exclusion (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitVPCBlockPublicAccessExclusionDeletedV2(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Block Public Access Exclusion (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func vpcBlockPublicAccessExclusionParseResourceARN(v string) (string, string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for resource ARN (%s), expected RESOURCE-TYPE/RESOURCE-ID", v)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCBlockPublicAccessExclusion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_block_public_access_exclusion.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_vpc(rName, "allow-bidirectional"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "internet_gateway_exclusion_mode", "allow-bidirectional"),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_arn"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_vpc(rName, "allow-egress"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "internet_gateway_exclusion_mode", "allow-egress"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCBlockPublicAccessExclusion_subnet(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_block_public_access_exclusion.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_subnet(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "internet_gateway_exclusion_mode", "allow-egress"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", "aws_subnet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_id", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCBlockPublicAccessExclusion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_block_public_access_exclusion.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_vpc(rName, "allow-bidirectional"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceVPCBlockPublicAccessExclusion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCBlockPublicAccessExclusion_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_block_public_access_exclusion.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCBlockPublicAccessExclusionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Block Public Access Exclusion ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindVPCBlockPublicAccessExclusionByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_block_public_access_exclusion" {
+				continue
+			}
+
+			_, err := tfec2.FindVPCBlockPublicAccessExclusionByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("VPC Block Public Access Exclusion %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCBlockPublicAccessExclusionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccVPCBlockPublicAccessExclusionConfig_vpc(rName, mode string) string {
+	return acctest.ConfigCompose(testAccVPCBlockPublicAccessExclusionConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpc_block_public_access_exclusion" "test" {
+  internet_gateway_exclusion_mode = %[1]q
+  vpc_id                          = aws_vpc.test.id
+}
+`, mode))
+}
+
+func testAccVPCBlockPublicAccessExclusionConfig_subnet(rName string) string {
+	return acctest.ConfigCompose(testAccVPCBlockPublicAccessExclusionConfig_base(rName), fmt.Sprintf(`
+resource "aws_subnet" "test" {
+  cidr_block = "10.1.1.0/24"
+  vpc_id     = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_block_public_access_exclusion" "test" {
+  internet_gateway_exclusion_mode = "allow-egress"
+  subnet_id                       = aws_subnet.test.id
+}
+`, rName))
+}
+
+func testAccVPCBlockPublicAccessExclusionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccVPCBlockPublicAccessExclusionConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpc_block_public_access_exclusion" "test" {
+  internet_gateway_exclusion_mode = "allow-bidirectional"
+  vpc_id                          = aws_vpc.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccVPCBlockPublicAccessExclusionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccVPCBlockPublicAccessExclusionConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpc_block_public_access_exclusion" "test" {
+  internet_gateway_exclusion_mode = "allow-bidirectional"
+  vpc_id                          = aws_vpc.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
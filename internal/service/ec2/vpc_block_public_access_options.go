@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKResource("aws_vpc_block_public_access_options", name="VPC Block Public Access Options")
+func ResourceVPCBlockPublicAccessOptions() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCBlockPublicAccessOptionsPut,
+		ReadWithoutTimeout:   resourceVPCBlockPublicAccessOptionsRead,
+		UpdateWithoutTimeout: resourceVPCBlockPublicAccessOptionsPut,
+		DeleteWithoutTimeout: resourceVPCBlockPublicAccessOptionsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"internet_gateway_block_mode": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.InternetGatewayBlockMode](),
+			},
+		},
+	}
+}
+
+func resourceVPCBlockPublicAccessOptionsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	mode := d.Get("internet_gateway_block_mode").(string)
+	input := &ec2.ModifyVpcBlockPublicAccessOptionsInput{
+		InternetGatewayBlockMode: awstypes.InternetGatewayBlockMode(mode),
+	}
+
+	_, err := conn.ModifyVpcBlockPublicAccessOptions(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting VPC Block Public Access Options (%s): %s", mode, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if _, err := waitVPCBlockPublicAccessOptionsUpdatedV2(ctx, conn, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Block Public Access Options (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceVPCBlockPublicAccessOptionsRead(ctx, d, meta)...)
+}
+
+func resourceVPCBlockPublicAccessOptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	options, err := findVPCBlockPublicAccessOptionsV2(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Block Public Access Options (%s): %s", d.Id(), err)
+	}
+
+	d.Set("aws_account_id", options.AwsAccountId)
+	d.Set("aws_region", options.AwsRegion)
+	d.Set("internet_gateway_block_mode", options.InternetGatewayBlockMode)
+
+	return diags
+}
+
+func resourceVPCBlockPublicAccessOptionsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// Removing the resource turns VPC Block Public Access off, the AWS default.
+	_, err := conn.ModifyVpcBlockPublicAccessOptions(ctx, &ec2.ModifyVpcBlockPublicAccessOptionsInput{
+		InternetGatewayBlockMode: awstypes.InternetGatewayBlockModeOff,
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling VPC Block Public Access Options (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitVPCBlockPublicAccessOptionsUpdatedV2(ctx, conn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for VPC Block Public Access Options (%s) update: %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -3322,3 +3322,83 @@ func WaitInstanceConnectEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Cli
 
 	return nil, err
 }
+
+func WaitVerifiedAccessEndpointCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.VerifiedAccessEndpointStatusCodePending},
+		Target:  []string{ec2.VerifiedAccessEndpointStatusCodeActive},
+		Refresh: StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		if output.Status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.VerifiedAccessEndpointStatusCodeUpdating},
+		Target:  []string{ec2.VerifiedAccessEndpointStatusCodeActive},
+		Refresh: StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		if output.Status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.VerifiedAccessEndpointStatusCodeDeleting, ec2.VerifiedAccessEndpointStatusCodeActive},
+		Target:  []string{},
+		Refresh: StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		if output.Status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitImageBlockPublicAccessState(ctx context.Context, conn *ec2.EC2, target string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing, ec2.ImageBlockPublicAccessDisabledStateUnblocked},
+		Target:  []string{target},
+		Refresh: StatusImageBlockPublicAccessState(ctx, conn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
@@ -97,3 +97,83 @@ func waitVPCIPv6CIDRBlockAssociationDeletedV2(ctx context.Context, conn *ec2.Cli
 
 	return nil, err
 }
+
+func waitVPCBlockPublicAccessOptionsUpdatedV2(ctx context.Context, conn *ec2.Client, timeout time.Duration) (*types.VpcBlockPublicAccessOptions, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessStateUpdateInProgress),
+		Target:  enum.Slice(types.VpcBlockPublicAccessStateUpdateComplete, types.VpcBlockPublicAccessStateDefaultState),
+		Refresh: statusVPCBlockPublicAccessOptionsStateV2(ctx, conn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessOptions); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCBlockPublicAccessExclusionCreatedV2(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*types.VpcBlockPublicAccessExclusion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessExclusionStateCreateInProgress),
+		Target:  enum.Slice(types.VpcBlockPublicAccessExclusionStateCreateComplete),
+		Refresh: statusVPCBlockPublicAccessExclusionStateV2(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessExclusion); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCBlockPublicAccessExclusionUpdatedV2(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*types.VpcBlockPublicAccessExclusion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessExclusionStateUpdateInProgress),
+		Target:  enum.Slice(types.VpcBlockPublicAccessExclusionStateUpdateComplete),
+		Refresh: statusVPCBlockPublicAccessExclusionStateV2(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessExclusion); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCBlockPublicAccessExclusionDeletedV2(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*types.VpcBlockPublicAccessExclusion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessExclusionStateDeleteInProgress),
+		Target:  []string{},
+		Refresh: statusVPCBlockPublicAccessExclusionStateV2(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessExclusion); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
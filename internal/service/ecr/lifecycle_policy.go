@@ -52,6 +52,7 @@ func ResourceLifecyclePolicy() *schema.Resource {
 
 					return equal
 				},
+				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
@@ -188,26 +189,27 @@ func resourceLifecyclePolicyDelete(ctx context.Context, d *schema.ResourceData,
 }
 
 type lifecyclePolicyRuleSelection struct {
-	TagStatus     *string   `locationName:"tagStatus" type:"string" enum:"tagStatus" required:"true"`
-	TagPrefixList []*string `locationName:"tagPrefixList" type:"list"`
-	CountType     *string   `locationName:"countType" type:"string" enum:"countType" required:"true"`
-	CountUnit     *string   `locationName:"countUnit" type:"string" enum:"countType"`
-	CountNumber   *int64    `locationName:"countNumber" min:"1" type:"integer"`
+	TagStatus      *string   `json:"tagStatus,omitempty" locationName:"tagStatus" type:"string" enum:"tagStatus" required:"true"`
+	TagPatternList []*string `json:"tagPatternList,omitempty" locationName:"tagPatternList" type:"list"`
+	TagPrefixList  []*string `json:"tagPrefixList,omitempty" locationName:"tagPrefixList" type:"list"`
+	CountType      *string   `json:"countType,omitempty" locationName:"countType" type:"string" enum:"countType" required:"true"`
+	CountUnit      *string   `json:"countUnit,omitempty" locationName:"countUnit" type:"string" enum:"countType"`
+	CountNumber    *int64    `json:"countNumber,omitempty" locationName:"countNumber" min:"1" type:"integer"`
 }
 
 type lifecyclePolicyRuleAction struct {
-	ActionType *string `locationName:"type" type:"string" required:"true"`
+	ActionType *string `json:"type,omitempty" locationName:"type" type:"string" required:"true"`
 }
 
 type lifecyclePolicyRule struct {
-	RulePriority *int64                        `locationName:"rulePriority" type:"integer" required:"true"`
-	Description  *string                       `locationName:"description" type:"string"`
-	Selection    *lifecyclePolicyRuleSelection `locationName:"selection" type:"structure" required:"true"`
-	Action       *lifecyclePolicyRuleAction    `locationName:"action" type:"structure" required:"true"`
+	RulePriority *int64                        `json:"rulePriority,omitempty" locationName:"rulePriority" type:"integer" required:"true"`
+	Description  *string                       `json:"description,omitempty" locationName:"description" type:"string"`
+	Selection    *lifecyclePolicyRuleSelection `json:"selection,omitempty" locationName:"selection" type:"structure" required:"true"`
+	Action       *lifecyclePolicyRuleAction    `json:"action,omitempty" locationName:"action" type:"structure" required:"true"`
 }
 
 type lifecyclePolicy struct {
-	Rules []*lifecyclePolicyRule `locationName:"rules" min:"1" type:"list" required:"true"`
+	Rules []*lifecyclePolicyRule `json:"rules" locationName:"rules" min:"1" type:"list" required:"true"`
 }
 
 func (lp *lifecyclePolicy) reduce() {
@@ -216,11 +218,21 @@ func (lp *lifecyclePolicy) reduce() {
 	})
 
 	for _, rule := range lp.Rules {
-		rule.Selection.reduce()
+		if rule.Selection != nil {
+			rule.Selection.reduce()
+		}
 	}
 }
 
 func (lprs *lifecyclePolicyRuleSelection) reduce() {
+	sort.Slice(lprs.TagPatternList, func(i, j int) bool {
+		return aws.StringValue(lprs.TagPatternList[i]) < aws.StringValue(lprs.TagPatternList[j])
+	})
+
+	if len(lprs.TagPatternList) == 0 {
+		lprs.TagPatternList = nil
+	}
+
 	sort.Slice(lprs.TagPrefixList, func(i, j int) bool {
 		return aws.StringValue(lprs.TagPrefixList[i]) < aws.StringValue(lprs.TagPrefixList[j])
 	})
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	lifecyclePolicyActionTypeExpire = "expire"
+
+	lifecyclePolicyCountTypeImageCountMoreThan = "imageCountMoreThan"
+	lifecyclePolicyCountTypeSinceImagePushed   = "sinceImagePushed"
+
+	lifecyclePolicyCountUnitDays = "days"
+
+	lifecyclePolicyTagStatusAny      = "any"
+	lifecyclePolicyTagStatusTagged   = "tagged"
+	lifecyclePolicyTagStatusUntagged = "untagged"
+)
+
+// @SDKDataSource("aws_ecr_lifecycle_policy_document")
+func DataSourceLifecyclePolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLifecyclePolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      lifecyclePolicyActionTypeExpire,
+										ValidateFunc: validation.StringInSlice([]string{lifecyclePolicyActionTypeExpire}, false),
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"selection": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count_number": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"count_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											lifecyclePolicyCountTypeImageCountMoreThan,
+											lifecyclePolicyCountTypeSinceImagePushed,
+										}, false),
+									},
+									"count_unit": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{lifecyclePolicyCountUnitDays}, false),
+									},
+									"tag_pattern_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 128),
+										},
+									},
+									"tag_prefix_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 128),
+										},
+									},
+									"tag_status": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  lifecyclePolicyTagStatusAny,
+										ValidateFunc: validation.StringInSlice([]string{
+											lifecyclePolicyTagStatusAny,
+											lifecyclePolicyTagStatusTagged,
+											lifecyclePolicyTagStatusUntagged,
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLifecyclePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := &lifecyclePolicy{
+		Rules: expandLifecyclePolicyRules(d.Get("rule").([]interface{})),
+	}
+
+	if err := validateLifecyclePolicy(doc); err != nil {
+		return sdkdiag.AppendErrorf(diags, "validating ECR Lifecycle Policy Document: %s", err)
+	}
+
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		// should never happen if the above code is correct
+		return sdkdiag.AppendErrorf(diags, "writing ECR Lifecycle Policy Document: formatting JSON: %s", err)
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+// validateLifecyclePolicy enforces the rule evaluation constraints documented in
+// https://docs.aws.amazon.com/AmazonECR/latest/userguide/LifecyclePolicies.html#lifecycle_policy_parameters.
+func validateLifecyclePolicy(lp *lifecyclePolicy) error {
+	priorities := make(map[int64]struct{})
+	var maxPriority, anyPriority int64
+
+	for _, rule := range lp.Rules {
+		priority := aws.Int64Value(rule.RulePriority)
+
+		if _, ok := priorities[priority]; ok {
+			return fmt.Errorf("rule priority (%d) must be unique", priority)
+		}
+		priorities[priority] = struct{}{}
+
+		if priority > maxPriority {
+			maxPriority = priority
+		}
+
+		selection := rule.Selection
+
+		switch tagStatus := aws.StringValue(selection.TagStatus); tagStatus {
+		case lifecyclePolicyTagStatusTagged:
+			if len(selection.TagPatternList) == 0 && len(selection.TagPrefixList) == 0 {
+				return fmt.Errorf("rule (%d): one of tag_pattern_list or tag_prefix_list must be specified when tag_status is %q", priority, tagStatus)
+			}
+
+			if len(selection.TagPatternList) > 0 && len(selection.TagPrefixList) > 0 {
+				return fmt.Errorf("rule (%d): only one of tag_pattern_list or tag_prefix_list may be specified", priority)
+			}
+		default:
+			if len(selection.TagPatternList) > 0 || len(selection.TagPrefixList) > 0 {
+				return fmt.Errorf("rule (%d): tag_pattern_list and tag_prefix_list can only be specified when tag_status is %q", priority, lifecyclePolicyTagStatusTagged)
+			}
+
+			if tagStatus == lifecyclePolicyTagStatusAny {
+				if anyPriority != 0 {
+					return fmt.Errorf("rule (%d): only one rule may have tag_status %q", priority, tagStatus)
+				}
+
+				anyPriority = priority
+			}
+		}
+
+		switch countType := aws.StringValue(selection.CountType); countType {
+		case lifecyclePolicyCountTypeImageCountMoreThan:
+			if selection.CountUnit != nil {
+				return fmt.Errorf("rule (%d): count_unit cannot be specified when count_type is %q", priority, countType)
+			}
+		case lifecyclePolicyCountTypeSinceImagePushed:
+			if selection.CountUnit == nil {
+				return fmt.Errorf("rule (%d): count_unit must be specified when count_type is %q", priority, countType)
+			}
+		}
+	}
+
+	if anyPriority != 0 && anyPriority != maxPriority {
+		return fmt.Errorf("rule (%d): a rule with tag_status %q must have the highest priority", anyPriority, lifecyclePolicyTagStatusAny)
+	}
+
+	return nil
+}
+
+func expandLifecyclePolicyRules(tfList []interface{}) []*lifecyclePolicyRule {
+	apiObjects := make([]*lifecyclePolicyRule, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lifecyclePolicyRule{
+			Action: &lifecyclePolicyRuleAction{
+				ActionType: aws.String(lifecyclePolicyActionTypeExpire),
+			},
+			RulePriority: aws.Int64(int64(tfMap["priority"].(int))),
+			Selection:    expandLifecyclePolicyRuleSelection(tfMap["selection"].([]interface{})),
+		}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["type"].(string); ok && v != "" {
+				apiObject.Action.ActionType = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLifecyclePolicyRuleSelection(tfList []interface{}) *lifecyclePolicyRuleSelection {
+	apiObject := &lifecyclePolicyRuleSelection{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["count_number"].(int); ok && v != 0 {
+		apiObject.CountNumber = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["count_type"].(string); ok && v != "" {
+		apiObject.CountType = aws.String(v)
+	}
+
+	if v, ok := tfMap["count_unit"].(string); ok && v != "" {
+		apiObject.CountUnit = aws.String(v)
+	}
+
+	if v, ok := tfMap["tag_pattern_list"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TagPatternList = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["tag_prefix_list"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TagPrefixList = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["tag_status"].(string); ok && v != "" {
+		apiObject.TagStatus = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECRLifecyclePolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecr_lifecycle_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccLifecyclePolicyDocumentDataSourceConfig_basic_ExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRLifecyclePolicyDocumentDataSource_tagPatternList(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecr_lifecycle_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyDocumentDataSourceConfig_tagPatternList,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccLifecyclePolicyDocumentDataSourceConfig_tagPatternList_ExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRLifecyclePolicyDocumentDataSource_duplicatePriority(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_duplicatePriority,
+				ExpectError: regexp.MustCompile(`rule priority \(1\) must be unique`),
+			},
+		},
+	})
+}
+
+func TestAccECRLifecyclePolicyDocumentDataSource_anyNotLast(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_anyNotLast,
+				ExpectError: regexp.MustCompile(`must have the highest priority`),
+			},
+		},
+	})
+}
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_basic = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority    = 1
+    description = "This is a test."
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["prod"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 100
+    }
+  }
+
+  rule {
+    priority = 2
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "sinceImagePushed"
+      count_unit   = "days"
+      count_number = 14
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_basic_ExpectedJSON = `{
+  "rules": [
+    {
+      "rulePriority": 1,
+      "description": "This is a test.",
+      "selection": {
+        "tagStatus": "tagged",
+        "tagPrefixList": ["prod"],
+        "countType": "imageCountMoreThan",
+        "countNumber": 100
+      },
+      "action": {
+        "type": "expire"
+      }
+    },
+    {
+      "rulePriority": 2,
+      "selection": {
+        "tagStatus": "untagged",
+        "countType": "sinceImagePushed",
+        "countUnit": "days",
+        "countNumber": 14
+      },
+      "action": {
+        "type": "expire"
+      }
+    }
+  ]
+}`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_tagPatternList = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status       = "tagged"
+      tag_pattern_list = ["*test*1*2*3", "test*1*2*3*"]
+      count_type       = "imageCountMoreThan"
+      count_number     = 1
+    }
+  }
+
+  rule {
+    priority = 2
+
+    selection {
+      count_type   = "imageCountMoreThan"
+      count_number = 20
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_tagPatternList_ExpectedJSON = `{
+  "rules": [
+    {
+      "rulePriority": 1,
+      "selection": {
+        "tagStatus": "tagged",
+        "tagPatternList": ["*test*1*2*3", "test*1*2*3*"],
+        "countType": "imageCountMoreThan",
+        "countNumber": 1
+      },
+      "action": {
+        "type": "expire"
+      }
+    },
+    {
+      "rulePriority": 2,
+      "selection": {
+        "tagStatus": "any",
+        "countType": "imageCountMoreThan",
+        "countNumber": 20
+      },
+      "action": {
+        "type": "expire"
+      }
+    }
+  ]
+}`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_duplicatePriority = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["prod"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 10
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_anyNotLast = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "any"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+
+  rule {
+    priority = 2
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["prod"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 10
+    }
+  }
+}
+`
@@ -96,6 +96,62 @@ func TestAccECRLifecyclePolicy_detectDiff(t *testing.T) {
 	})
 }
 
+func TestAccECRLifecyclePolicy_detectTagPatternListDiff(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_tagPatternList(rName, `"*test*1*2*3", "test*1*2*3*"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+				),
+			},
+			{
+				Config:   testAccLifecyclePolicyConfig_tagPatternList(rName, `"test*1*2*3*", "*test*1*2*3"`),
+				PlanOnly: true,
+			},
+			{
+				Config:             testAccLifecyclePolicyConfig_tagPatternList(rName, `"*test*1*2*3", "prod*"`),
+				ExpectNonEmptyPlan: true,
+				PlanOnly:           true,
+			},
+		},
+	})
+}
+
+func TestAccECRLifecyclePolicy_lifecyclePolicyDocument(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_lifecyclePolicyDocument(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckLifecyclePolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn(ctx)
@@ -302,3 +358,71 @@ resource "aws_ecr_lifecycle_policy" "test" {
 }
 `, rName)
 }
+
+func testAccLifecyclePolicyConfig_tagPatternList(rName, tagPatternList string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecr_lifecycle_policy" "test" {
+  repository = aws_ecr_repository.test.name
+
+  policy = jsonencode({
+    rules = [
+      {
+        rulePriority = 1
+        description  = "Expire matching tagged images"
+        selection = {
+          tagStatus      = "tagged"
+          tagPatternList = [%[2]s]
+          countType      = "imageCountMoreThan"
+          countNumber    = 1
+        }
+        action = {
+          type = "expire"
+        }
+      },
+    ]
+  })
+}
+`, rName, tagPatternList)
+}
+
+func testAccLifecyclePolicyConfig_lifecyclePolicyDocument(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority    = 1
+    description = "Expire images older than 14 days"
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "sinceImagePushed"
+      count_unit   = "days"
+      count_number = 14
+    }
+  }
+
+  rule {
+    priority = 2
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["first", "second"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 10
+    }
+  }
+}
+
+resource "aws_ecr_lifecycle_policy" "test" {
+  repository = aws_ecr_repository.test.name
+  policy     = data.aws_ecr_lifecycle_policy_document.test.json
+}
+`, rName)
+}
@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ecr_repository_creation_template", name="Repository Creation Template")
+func ResourceRepositoryCreationTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRepositoryCreationTemplateCreate,
+		ReadWithoutTimeout:   resourceRepositoryCreationTemplateRead,
+		UpdateWithoutTimeout: resourceRepositoryCreationTemplateUpdate,
+		DeleteWithoutTimeout: resourceRepositoryCreationTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"applied_for": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ecr.RCTAppliedFor_Values(), false),
+				},
+			},
+			"custom_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ecr.EncryptionTypeAes256,
+							ValidateFunc: validation.StringInSlice(ecr.EncryptionType_Values(), false),
+						},
+						"kms_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(0, 2048),
+						},
+					},
+				},
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+			},
+			"image_tag_mutability": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ecr.ImageTagMutabilityMutable,
+				ValidateFunc: validation.StringInSlice(ecr.ImageTagMutability_Values(), false),
+			},
+			"lifecycle_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := equivalentLifecyclePolicyJSON(old, new)
+
+					return equal
+				},
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(
+						regexp.MustCompile(`^((?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*/?|ROOT)$`),
+						"must only include lowercase alphanumeric, underscore, period, hyphen or forward slash characters, or be ROOT"),
+				),
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository_policy": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func resourceRepositoryCreationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRConn(ctx)
+
+	prefix := d.Get("prefix").(string)
+	input := &ecr.CreateRepositoryCreationTemplateInput{
+		AppliedFor:         flex.ExpandStringSet(d.Get("applied_for").(*schema.Set)),
+		ImageTagMutability: aws.String(d.Get("image_tag_mutability").(string)),
+		Prefix:             aws.String(prefix),
+	}
+
+	if v, ok := d.GetOk("custom_role_arn"); ok {
+		input.CustomRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.EncryptionConfiguration = expandRepositoryCreationTemplateEncryptionConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("lifecycle_policy"); ok {
+		policy, err := structure.NormalizeJsonString(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "lifecycle_policy (%s) is invalid JSON: %s", v.(string), err)
+		}
+
+		input.LifecyclePolicy = aws.String(policy)
+	}
+
+	if v, ok := d.GetOk("repository_policy"); ok {
+		policy, err := structure.NormalizeJsonString(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "repository_policy (%s) is invalid JSON: %s", v.(string), err)
+		}
+
+		input.RepositoryPolicy = aws.String(policy)
+	}
+
+	if v, ok := d.GetOk("resource_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		input.ResourceTags = Tags(tftags.New(ctx, v.(map[string]interface{})))
+	}
+
+	output, err := conn.CreateRepositoryCreationTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ECR Repository Creation Template (%s): %s", prefix, err)
+	}
+
+	d.SetId(aws.StringValue(output.RepositoryCreationTemplate.Prefix))
+
+	return append(diags, resourceRepositoryCreationTemplateRead(ctx, d, meta)...)
+}
+
+func resourceRepositoryCreationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRConn(ctx)
+
+	rct, registryID, err := FindRepositoryCreationTemplateByPrefix(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECR Repository Creation Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Repository Creation Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("applied_for", aws.StringValueSlice(rct.AppliedFor))
+	d.Set("custom_role_arn", rct.CustomRoleArn)
+	d.Set("description", rct.Description)
+	if err := d.Set("encryption_configuration", flattenRepositoryCreationTemplateEncryptionConfiguration(rct.EncryptionConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting encryption_configuration: %s", err)
+	}
+	d.Set("image_tag_mutability", rct.ImageTagMutability)
+
+	if equivalent, err := equivalentLifecyclePolicyJSON(d.Get("lifecycle_policy").(string), aws.StringValue(rct.LifecyclePolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "while comparing lifecycle_policy (state: %s) (from AWS: %s), encountered: %s", d.Get("lifecycle_policy").(string), aws.StringValue(rct.LifecyclePolicy), err)
+	} else if !equivalent {
+		policyToSet, err := structure.NormalizeJsonString(aws.StringValue(rct.LifecyclePolicy))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "lifecycle_policy (%s) is invalid JSON: %s", policyToSet, err)
+		}
+
+		d.Set("lifecycle_policy", policyToSet)
+	}
+	d.Set("prefix", rct.Prefix)
+	d.Set("registry_id", registryID)
+
+	policyToSet, err := verify.PolicyToSet(d.Get("repository_policy").(string), aws.StringValue(rct.RepositoryPolicy))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set("repository_policy", policyToSet)
+	d.Set("resource_tags", KeyValueTags(ctx, rct.ResourceTags).Map())
+
+	return diags
+}
+
+func resourceRepositoryCreationTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRConn(ctx)
+
+	input := &ecr.UpdateRepositoryCreationTemplateInput{
+		AppliedFor:         flex.ExpandStringSet(d.Get("applied_for").(*schema.Set)),
+		CustomRoleArn:      aws.String(d.Get("custom_role_arn").(string)),
+		Description:        aws.String(d.Get("description").(string)),
+		ImageTagMutability: aws.String(d.Get("image_tag_mutability").(string)),
+		Prefix:             aws.String(d.Id()),
+		ResourceTags:       Tags(tftags.New(ctx, d.Get("resource_tags").(map[string]interface{}))),
+	}
+
+	if v, ok := d.GetOk("encryption_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.EncryptionConfiguration = expandRepositoryCreationTemplateEncryptionConfiguration(v.([]interface{}))
+	}
+
+	policy, err := structure.NormalizeJsonString(d.Get("lifecycle_policy").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "lifecycle_policy (%s) is invalid JSON: %s", d.Get("lifecycle_policy").(string), err)
+	}
+
+	input.LifecyclePolicy = aws.String(policy)
+
+	policy, err = structure.NormalizeJsonString(d.Get("repository_policy").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "repository_policy (%s) is invalid JSON: %s", d.Get("repository_policy").(string), err)
+	}
+
+	input.RepositoryPolicy = aws.String(policy)
+
+	_, err = conn.UpdateRepositoryCreationTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating ECR Repository Creation Template (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRepositoryCreationTemplateRead(ctx, d, meta)...)
+}
+
+func resourceRepositoryCreationTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRConn(ctx)
+
+	log.Printf("[DEBUG] Deleting ECR Repository Creation Template: %s", d.Id())
+	_, err := conn.DeleteRepositoryCreationTemplateWithContext(ctx, &ecr.DeleteRepositoryCreationTemplateInput{
+		Prefix: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeTemplateNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ECR Repository Creation Template (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindRepositoryCreationTemplateByPrefix(ctx context.Context, conn *ecr.ECR, prefix string) (*ecr.RepositoryCreationTemplate, string, error) {
+	input := &ecr.DescribeRepositoryCreationTemplatesInput{
+		Prefixes: aws.StringSlice([]string{prefix}),
+	}
+
+	output, err := conn.DescribeRepositoryCreationTemplatesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeTemplateNotFoundException) {
+		return nil, "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	if output == nil || len(output.RepositoryCreationTemplates) == 0 || output.RepositoryCreationTemplates[0] == nil {
+		return nil, "", tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.RepositoryCreationTemplates); count > 1 {
+		return nil, "", tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.RepositoryCreationTemplates[0], aws.StringValue(output.RegistryId), nil
+}
+
+func expandRepositoryCreationTemplateEncryptionConfiguration(tfList []interface{}) *ecr.EncryptionConfigurationForRepositoryCreationTemplate {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &ecr.EncryptionConfigurationForRepositoryCreationTemplate{
+		EncryptionType: aws.String(tfMap["encryption_type"].(string)),
+	}
+
+	if v, ok := tfMap["kms_key"].(string); ok && v != "" {
+		apiObject.KmsKey = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenRepositoryCreationTemplateEncryptionConfiguration(apiObject *ecr.EncryptionConfigurationForRepositoryCreationTemplate) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"encryption_type": aws.StringValue(apiObject.EncryptionType),
+		"kms_key":         aws.StringValue(apiObject.KmsKey),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -462,6 +462,92 @@ func ResourceService() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"volume_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_ebs_volume": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encrypted": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"file_system_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(ecs.TaskFilesystemType_Values(), false),
+									},
+									"iops": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"size_in_gb": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"snapshot_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"tag_specifications": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"propagate_tags": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(ecs.PropagateTags_Values(), false),
+												},
+												"resource_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(ecs.EBSResourceType_Values(), false),
+												},
+												"tags": tftags.TagsSchema(),
+											},
+										},
+									},
+									"throughput": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"volume_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"wait_for_steady_state": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -601,6 +687,10 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.TaskDefinition = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("volume_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.VolumeConfigurations = expandServiceVolumeConfigurations(ctx, v.([]interface{}))
+	}
+
 	output, err := serviceCreateWithRetry(ctx, conn, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -772,6 +862,16 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "setting service_registries: %s", err)
 	}
 
+	// Volume configurations are only reported on the service's deployments.
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Status) == serviceDeploymentStatusPrimary {
+			if err := d.Set("volume_configuration", flattenServiceVolumeConfigurations(ctx, deployment.VolumeConfigurations)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting volume_configuration: %s", err)
+			}
+			break
+		}
+	}
+
 	setTagsOut(ctx, service.Tags)
 
 	return diags
@@ -913,6 +1013,15 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.TaskDefinition = aws.String(d.Get("task_definition").(string))
 		}
 
+		if d.HasChange("volume_configuration") {
+			// To remove an existing volume configuration, specify an empty array.
+			input.VolumeConfigurations = []*ecs.ServiceVolumeConfiguration{}
+
+			if v, ok := d.GetOk("volume_configuration"); ok && len(v.([]interface{})) > 0 {
+				input.VolumeConfigurations = expandServiceVolumeConfigurations(ctx, v.([]interface{}))
+			}
+		}
+
 		// Retry due to IAM eventual consistency
 		err := retry.RetryContext(ctx, propagationTimeout+serviceUpdateTimeout, func() *retry.RetryError {
 			_, err := conn.UpdateServiceWithContext(ctx, input)
@@ -1496,6 +1605,168 @@ func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface
 	return results
 }
 
+func expandServiceVolumeConfigurations(ctx context.Context, tfList []interface{}) []*ecs.ServiceVolumeConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &ecs.ServiceVolumeConfiguration{
+		Name: aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["managed_ebs_volume"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ManagedEBSVolume = expandServiceManagedEBSVolumeConfiguration(ctx, v)
+	}
+
+	return []*ecs.ServiceVolumeConfiguration{apiObject}
+}
+
+func expandServiceManagedEBSVolumeConfiguration(ctx context.Context, tfList []interface{}) *ecs.ServiceManagedEBSVolumeConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &ecs.ServiceManagedEBSVolumeConfiguration{
+		RoleArn: aws.String(tfMap["role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["encrypted"].(bool); ok {
+		apiObject.Encrypted = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["file_system_type"].(string); ok && v != "" {
+		apiObject.FilesystemType = aws.String(v)
+	}
+
+	if v, ok := tfMap["iops"].(int); ok && v != 0 {
+		apiObject.Iops = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["size_in_gb"].(int); ok && v != 0 {
+		apiObject.SizeInGiB = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["snapshot_id"].(string); ok && v != "" {
+		apiObject.SnapshotId = aws.String(v)
+	}
+
+	if v, ok := tfMap["tag_specifications"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TagSpecifications = expandEBSTagSpecifications(ctx, v)
+	}
+
+	if v, ok := tfMap["throughput"].(int); ok && v != 0 {
+		apiObject.Throughput = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["volume_type"].(string); ok && v != "" {
+		apiObject.VolumeType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandEBSTagSpecifications(ctx context.Context, tfList []interface{}) []*ecs.EBSTagSpecification {
+	var apiObjects []*ecs.EBSTagSpecification
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.EBSTagSpecification{
+			ResourceType: aws.String(tfMap["resource_type"].(string)),
+		}
+
+		if v, ok := tfMap["propagate_tags"].(string); ok && v != "" {
+			apiObject.PropagateTags = aws.String(v)
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Tags = Tags(tftags.New(ctx, v).IgnoreAWS())
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenServiceVolumeConfigurations(ctx context.Context, apiObjects []*ecs.ServiceVolumeConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.ManagedEBSVolume; v != nil {
+			tfMap["managed_ebs_volume"] = flattenServiceManagedEBSVolumeConfiguration(ctx, v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenServiceManagedEBSVolumeConfiguration(ctx context.Context, apiObject *ecs.ServiceManagedEBSVolumeConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"encrypted":          aws.BoolValue(apiObject.Encrypted),
+		"file_system_type":   aws.StringValue(apiObject.FilesystemType),
+		"iops":               aws.Int64Value(apiObject.Iops),
+		"kms_key_id":         aws.StringValue(apiObject.KmsKeyId),
+		"role_arn":           aws.StringValue(apiObject.RoleArn),
+		"size_in_gb":         aws.Int64Value(apiObject.SizeInGiB),
+		"snapshot_id":        aws.StringValue(apiObject.SnapshotId),
+		"tag_specifications": flattenEBSTagSpecifications(ctx, apiObject.TagSpecifications),
+		"throughput":         aws.Int64Value(apiObject.Throughput),
+		"volume_type":        aws.StringValue(apiObject.VolumeType),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenEBSTagSpecifications(ctx context.Context, apiObjects []*ecs.EBSTagSpecification) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"propagate_tags": aws.StringValue(apiObject.PropagateTags),
+			"resource_type":  aws.StringValue(apiObject.ResourceType),
+		}
+
+		if v := apiObject.Tags; len(v) > 0 {
+			tfMap["tags"] = KeyValueTags(ctx, v).IgnoreAWS().Map()
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func resourceLoadBalancerHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
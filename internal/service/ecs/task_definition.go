@@ -74,10 +74,13 @@ func ResourceTaskDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"container_definition": containerDefinitionSchema(),
 			"container_definitions": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container_definition", "container_definitions"},
 				StateFunc: func(v interface{}) string {
 					// Sort the lists of environment variables as they are serialized to state, so we won't get
 					// spurious reorderings in plans (diff is suppressed if the environment variables haven't changed,
@@ -282,6 +285,11 @@ func ResourceTaskDefinition() *schema.Resource {
 				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"configure_at_launch": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
 						"docker_volume_configuration": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -448,10 +456,15 @@ func resourceTaskDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
 
-	rawDefinitions := d.Get("container_definitions").(string)
-	definitions, err := expandContainerDefinitions(rawDefinitions)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Get("family").(string), err)
+	var definitions []*ecs.ContainerDefinition
+	if v, ok := d.GetOk("container_definition"); ok && len(v.([]interface{})) > 0 {
+		definitions = expandContainerDefinitionBlocks(v.([]interface{}))
+	} else {
+		var err error
+		definitions, err = expandContainerDefinitions(d.Get("container_definitions").(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Get("family").(string), err)
+		}
 	}
 
 	input := &ecs.RegisterTaskDefinitionInput{
@@ -611,6 +624,22 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition (%s): %s", d.Id(), err)
 	}
 
+	// The typed container definitions are only refreshed when they are no longer equivalent to the
+	// registered definitions, using the same canonicalisation as the JSON document.
+	if v, ok := d.GetOk("container_definition"); ok && len(v.([]interface{})) > 0 {
+		configured, err := flattenContainerDefinitions(expandContainerDefinitionBlocks(v.([]interface{})))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition (%s): %s", d.Id(), err)
+		}
+
+		isAWSVPC := aws.StringValue(taskDefinition.NetworkMode) == ecs.NetworkModeAwsvpc
+		if equal, _ := ContainerDefinitionsAreEquivalent(configured, defs, isAWSVPC); !equal {
+			if err := d.Set("container_definition", flattenContainerDefinitionBlocks(taskDefinition.ContainerDefinitions)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting container_definition: %s", err)
+			}
+		}
+	}
+
 	d.Set("task_role_arn", taskDefinition.TaskRoleArn)
 	d.Set("execution_role_arn", taskDefinition.ExecutionRoleArn)
 	d.Set("cpu", taskDefinition.Cpu)
@@ -685,6 +714,10 @@ func resourceTaskDefinitionVolumeHash(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
 	buf.WriteString(fmt.Sprintf("%s-", m["host_path"].(string)))
 
+	if v, ok := m["configure_at_launch"]; ok && v.(bool) {
+		buf.WriteString(fmt.Sprintf("%t-", v.(bool)))
+	}
+
 	if v, ok := m["efs_volume_configuration"]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		m := v.([]interface{})[0].(map[string]interface{})
 
@@ -908,6 +941,10 @@ func expandVolumes(configured []interface{}) []*ecs.Volume {
 			}
 		}
 
+		if v, ok := data["configure_at_launch"].(bool); ok && v {
+			l.ConfiguredAtLaunch = aws.Bool(v)
+		}
+
 		if v, ok := data["docker_volume_configuration"].([]interface{}); ok && len(v) > 0 {
 			l.DockerVolumeConfiguration = expandVolumesDockerVolume(v)
 		}
@@ -1040,6 +1077,10 @@ func flattenVolumes(list []*ecs.Volume) []map[string]interface{} {
 			l["host_path"] = aws.StringValue(volume.Host.SourcePath)
 		}
 
+		if volume.ConfiguredAtLaunch != nil {
+			l["configure_at_launch"] = aws.BoolValue(volume.ConfiguredAtLaunch)
+		}
+
 		if volume.DockerVolumeConfiguration != nil {
 			l["docker_volume_configuration"] = flattenDockerVolumeConfiguration(volume.DockerVolumeConfiguration)
 		}
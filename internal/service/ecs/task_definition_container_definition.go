@@ -0,0 +1,808 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// containerDefinitionSchema returns the schema of the typed alternative to the
+// container_definitions JSON document.
+// Only the most commonly used container definition fields are modelled; the
+// JSON document remains available for everything else.
+func containerDefinitionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"command": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"cpu": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+				"depends_on": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"condition": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ContainerCondition_Values(), false),
+							},
+							"container_name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"docker_labels": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"entry_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"environment": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"essential": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  true,
+				},
+				"health_check": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"interval": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Computed: true,
+								ForceNew: true,
+							},
+							"retries": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Computed: true,
+								ForceNew: true,
+							},
+							"start_period": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Computed: true,
+								ForceNew: true,
+							},
+							"timeout": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Computed: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"hostname": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"image": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"log_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_driver": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+							},
+							"options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"secret_option": containerDefinitionSecretSchema(),
+						},
+					},
+				},
+				"memory": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+				"memory_reservation": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+				"mount_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"container_path": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"read_only": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"source_volume": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"port_mapping": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"app_protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ApplicationProtocol_Values(), false),
+							},
+							"container_port": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+							"host_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumberOrZero,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+							},
+						},
+					},
+				},
+				"privileged": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"readonly_root_filesystem": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"repository_credentials_parameter": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"secret": containerDefinitionSecretSchema(),
+				"start_timeout": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+				"stop_timeout": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+				"ulimit": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"hard_limit": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.UlimitName_Values(), false),
+							},
+							"soft_limit": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"user": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"volumes_from": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"read_only": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"source_container": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"working_directory": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func containerDefinitionSecretSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"value_from": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func expandContainerDefinitionBlocks(tfList []interface{}) []*ecs.ContainerDefinition {
+	apiObjects := make([]*ecs.ContainerDefinition, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ContainerDefinition{
+			Essential: aws.Bool(tfMap["essential"].(bool)),
+			Image:     aws.String(tfMap["image"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+			apiObject.Cpu = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+			apiObject.DependsOn = expandContainerDependencies(v)
+		}
+
+		if v, ok := tfMap["docker_labels"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.DockerLabels = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EntryPoint = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["environment"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Environment = expandKeyValuePairs(v.List())
+		}
+
+		if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.HealthCheck = expandContainerHealthCheck(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["hostname"].(string); ok && v != "" {
+			apiObject.Hostname = aws.String(v)
+		}
+
+		if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.LogConfiguration = expandContainerLogConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.Memory = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+			apiObject.MemoryReservation = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["mount_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.MountPoints = expandMountPoints(v)
+		}
+
+		if v, ok := tfMap["port_mapping"].([]interface{}); ok && len(v) > 0 {
+			apiObject.PortMappings = expandPortMappings(v)
+		}
+
+		if v, ok := tfMap["privileged"].(bool); ok && v {
+			apiObject.Privileged = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+			apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["repository_credentials_parameter"].(string); ok && v != "" {
+			apiObject.RepositoryCredentials = &ecs.RepositoryCredentials{
+				CredentialsParameter: aws.String(v),
+			}
+		}
+
+		if v, ok := tfMap["secret"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Secrets = expandSecrets(v.List())
+		}
+
+		if v, ok := tfMap["start_timeout"].(int); ok && v != 0 {
+			apiObject.StartTimeout = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["stop_timeout"].(int); ok && v != 0 {
+			apiObject.StopTimeout = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["ulimit"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Ulimits = expandUlimits(v)
+		}
+
+		if v, ok := tfMap["user"].(string); ok && v != "" {
+			apiObject.User = aws.String(v)
+		}
+
+		if v, ok := tfMap["volumes_from"].([]interface{}); ok && len(v) > 0 {
+			apiObject.VolumesFrom = expandVolumesFrom(v)
+		}
+
+		if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+			apiObject.WorkingDirectory = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerDependencies(tfList []interface{}) []*ecs.ContainerDependency {
+	apiObjects := make([]*ecs.ContainerDependency, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.ContainerDependency{
+			Condition:     aws.String(tfMap["condition"].(string)),
+			ContainerName: aws.String(tfMap["container_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandKeyValuePairs(tfList []interface{}) []*ecs.KeyValuePair {
+	apiObjects := make([]*ecs.KeyValuePair, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.KeyValuePair{
+			Name:  aws.String(tfMap["name"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerHealthCheck(tfMap map[string]interface{}) *ecs.HealthCheck {
+	apiObject := &ecs.HealthCheck{
+		Command: flex.ExpandStringList(tfMap["command"].([]interface{})),
+	}
+
+	if v, ok := tfMap["interval"].(int); ok && v != 0 {
+		apiObject.Interval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["retries"].(int); ok && v != 0 {
+		apiObject.Retries = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+		apiObject.StartPeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timeout"].(int); ok && v != 0 {
+		apiObject.Timeout = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandContainerLogConfiguration(tfMap map[string]interface{}) *ecs.LogConfiguration {
+	apiObject := &ecs.LogConfiguration{
+		LogDriver: aws.String(tfMap["log_driver"].(string)),
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["secret_option"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecretOptions = expandSecrets(v.List())
+	}
+
+	return apiObject
+}
+
+func expandMountPoints(tfList []interface{}) []*ecs.MountPoint {
+	apiObjects := make([]*ecs.MountPoint, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.MountPoint{
+			ContainerPath: aws.String(tfMap["container_path"].(string)),
+			ReadOnly:      aws.Bool(tfMap["read_only"].(bool)),
+			SourceVolume:  aws.String(tfMap["source_volume"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandPortMappings(tfList []interface{}) []*ecs.PortMapping {
+	apiObjects := make([]*ecs.PortMapping, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.PortMapping{
+			ContainerPort: aws.Int64(int64(tfMap["container_port"].(int))),
+		}
+
+		if v, ok := tfMap["app_protocol"].(string); ok && v != "" {
+			apiObject.AppProtocol = aws.String(v)
+		}
+
+		if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+			apiObject.HostPort = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["protocol"].(string); ok && v != "" {
+			apiObject.Protocol = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSecrets(tfList []interface{}) []*ecs.Secret {
+	apiObjects := make([]*ecs.Secret, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.Secret{
+			Name:      aws.String(tfMap["name"].(string)),
+			ValueFrom: aws.String(tfMap["value_from"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandUlimits(tfList []interface{}) []*ecs.Ulimit {
+	apiObjects := make([]*ecs.Ulimit, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.Ulimit{
+			HardLimit: aws.Int64(int64(tfMap["hard_limit"].(int))),
+			Name:      aws.String(tfMap["name"].(string)),
+			SoftLimit: aws.Int64(int64(tfMap["soft_limit"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandVolumesFrom(tfList []interface{}) []*ecs.VolumeFrom {
+	apiObjects := make([]*ecs.VolumeFrom, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.VolumeFrom{
+			ReadOnly:        aws.Bool(tfMap["read_only"].(bool)),
+			SourceContainer: aws.String(tfMap["source_container"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenContainerDefinitionBlocks(apiObjects []*ecs.ContainerDefinition) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"command":                  aws.StringValueSlice(apiObject.Command),
+			"cpu":                      aws.Int64Value(apiObject.Cpu),
+			"depends_on":               flattenContainerDependencies(apiObject.DependsOn),
+			"docker_labels":            aws.StringValueMap(apiObject.DockerLabels),
+			"entry_point":              aws.StringValueSlice(apiObject.EntryPoint),
+			"environment":              flattenKeyValuePairs(apiObject.Environment),
+			"essential":                aws.BoolValue(apiObject.Essential),
+			"hostname":                 aws.StringValue(apiObject.Hostname),
+			"image":                    aws.StringValue(apiObject.Image),
+			"memory":                   aws.Int64Value(apiObject.Memory),
+			"memory_reservation":       aws.Int64Value(apiObject.MemoryReservation),
+			"mount_point":              flattenMountPoints(apiObject.MountPoints),
+			"name":                     aws.StringValue(apiObject.Name),
+			"port_mapping":             flattenPortMappings(apiObject.PortMappings),
+			"privileged":               aws.BoolValue(apiObject.Privileged),
+			"readonly_root_filesystem": aws.BoolValue(apiObject.ReadonlyRootFilesystem),
+			"secret":                   flattenSecrets(apiObject.Secrets),
+			"start_timeout":            aws.Int64Value(apiObject.StartTimeout),
+			"stop_timeout":             aws.Int64Value(apiObject.StopTimeout),
+			"ulimit":                   flattenUlimits(apiObject.Ulimits),
+			"user":                     aws.StringValue(apiObject.User),
+			"volumes_from":             flattenVolumesFrom(apiObject.VolumesFrom),
+			"working_directory":        aws.StringValue(apiObject.WorkingDirectory),
+		}
+
+		if v := apiObject.HealthCheck; v != nil {
+			tfMap["health_check"] = []interface{}{map[string]interface{}{
+				"command":      aws.StringValueSlice(v.Command),
+				"interval":     aws.Int64Value(v.Interval),
+				"retries":      aws.Int64Value(v.Retries),
+				"start_period": aws.Int64Value(v.StartPeriod),
+				"timeout":      aws.Int64Value(v.Timeout),
+			}}
+		}
+
+		if v := apiObject.LogConfiguration; v != nil {
+			tfMap["log_configuration"] = []interface{}{map[string]interface{}{
+				"log_driver":    aws.StringValue(v.LogDriver),
+				"options":       aws.StringValueMap(v.Options),
+				"secret_option": flattenSecrets(v.SecretOptions),
+			}}
+		}
+
+		if v := apiObject.RepositoryCredentials; v != nil {
+			tfMap["repository_credentials_parameter"] = aws.StringValue(v.CredentialsParameter)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerDependencies(apiObjects []*ecs.ContainerDependency) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"condition":      aws.StringValue(apiObject.Condition),
+			"container_name": aws.StringValue(apiObject.ContainerName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenKeyValuePairs(apiObjects []*ecs.KeyValuePair) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"name":  aws.StringValue(apiObject.Name),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMountPoints(apiObjects []*ecs.MountPoint) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"container_path": aws.StringValue(apiObject.ContainerPath),
+			"read_only":      aws.BoolValue(apiObject.ReadOnly),
+			"source_volume":  aws.StringValue(apiObject.SourceVolume),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPortMappings(apiObjects []*ecs.PortMapping) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"app_protocol":   aws.StringValue(apiObject.AppProtocol),
+			"container_port": aws.Int64Value(apiObject.ContainerPort),
+			"host_port":      aws.Int64Value(apiObject.HostPort),
+			"name":           aws.StringValue(apiObject.Name),
+			"protocol":       aws.StringValue(apiObject.Protocol),
+		})
+	}
+
+	return tfList
+}
+
+func flattenSecrets(apiObjects []*ecs.Secret) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"name":       aws.StringValue(apiObject.Name),
+			"value_from": aws.StringValue(apiObject.ValueFrom),
+		})
+	}
+
+	return tfList
+}
+
+func flattenUlimits(apiObjects []*ecs.Ulimit) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"hard_limit": aws.Int64Value(apiObject.HardLimit),
+			"name":       aws.StringValue(apiObject.Name),
+			"soft_limit": aws.Int64Value(apiObject.SoftLimit),
+		})
+	}
+
+	return tfList
+}
+
+func flattenVolumesFrom(apiObjects []*ecs.VolumeFrom) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"read_only":        aws.BoolValue(apiObject.ReadOnly),
+			"source_container": aws.StringValue(apiObject.SourceContainer),
+		})
+	}
+
+	return tfList
+}
@@ -930,6 +930,93 @@ func TestAccECSTaskDefinition_Fargate_ephemeralStorage(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_Fargate_configureAtLaunch(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_fargateConfigureAtLaunch(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "volume.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "volume.*", map[string]string{
+						"name":                rName,
+						"configure_at_launch": "true",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_containerDefinitionBlock(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlock(rName, "360"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.name", "sleep"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.image", "busybox"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.command.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.command.1", "360"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "container_definition.0.environment.*", map[string]string{
+						"name":  "FOO",
+						"value": "bar",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.essential", "true"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.container_port", "8000"),
+					resource.TestCheckResourceAttrSet(resourceName, "container_definitions"),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlock(rName, "720"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &after),
+					testAccCheckTaskDefinitionRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.command.1", "720"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"container_definition", "skip_destroy"},
+			},
+		},
+	})
+}
+
 func TestAccECSTaskDefinition_executionRole(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf ecs.TaskDefinition
@@ -1659,6 +1746,76 @@ TASK_DEFINITION
 `, rName, portMappings)
 }
 
+func testAccTaskDefinitionConfig_fargateConfigureAtLaunch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = <<TASK_DEFINITION
+[
+  {
+    "name": "sleep",
+    "image": "busybox",
+    "cpu": 10,
+    "command": ["sleep","360"],
+    "memory": 10,
+    "essential": true,
+    "mountPoints": [
+      {
+        "sourceVolume": %[1]q,
+        "containerPath": "/data"
+      }
+    ]
+  }
+]
+TASK_DEFINITION
+
+  volume {
+    name                = %[1]q
+    configure_at_launch = true
+  }
+}
+`, rName)
+}
+
+func testAccTaskDefinitionConfig_containerDefinitionBlock(rName, sleep string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definition {
+    name    = "sleep"
+    image   = "busybox"
+    cpu     = 10
+    memory  = 10
+    command = ["sleep", %[2]q]
+
+    environment {
+      name  = "FOO"
+      value = "bar"
+    }
+
+    environment {
+      name  = "BAZ"
+      value = "qux"
+    }
+
+    port_mapping {
+      container_port = 8000
+    }
+  }
+}
+`, rName, sleep)
+}
+
 func testAccTaskDefinitionConfig_executionRole(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
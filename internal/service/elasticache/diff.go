@@ -6,6 +6,7 @@ package elasticache
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,7 +43,7 @@ func CustomizeDiffClusterMemcachedNodeType(_ context.Context, diff *schema.Resou
 	if diff.Id() == "" || !diff.HasChange("node_type") {
 		return nil
 	}
-	if v, ok := diff.GetOk("engine"); !ok || v.(string) == engineRedis {
+	if v, ok := diff.GetOk("engine"); !ok || v.(string) != engineMemcached {
 		return nil
 	}
 	return diff.ForceNew("node_type")
@@ -50,7 +51,7 @@ func CustomizeDiffClusterMemcachedNodeType(_ context.Context, diff *schema.Resou
 
 // CustomizeDiffValidateClusterMemcachedSnapshotIdentifier validates that `final_snapshot_identifier` is not set when `engine` is "memcached"
 func CustomizeDiffValidateClusterMemcachedSnapshotIdentifier(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-	if v, ok := diff.GetOk("engine"); !ok || v.(string) == engineRedis {
+	if v, ok := diff.GetOk("engine"); !ok || v.(string) != engineMemcached {
 		return nil
 	}
 	if _, ok := diff.GetOk("final_snapshot_identifier"); !ok {
@@ -69,3 +70,17 @@ func CustomizeDiffValidateReplicationGroupAutomaticFailover(_ context.Context, d
 	}
 	return nil
 }
+
+// customizeDiffReplicationGroupEngine causes re-creation when `engine` is changed, unless it is changed from "redis" to "valkey"
+func customizeDiffReplicationGroupEngine(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("engine") {
+		return nil
+	}
+
+	// Redis replication groups can be switched to Valkey in-place.
+	if o, n := diff.GetChange("engine"); strings.EqualFold(o.(string), engineRedis) && strings.EqualFold(n.(string), engineValkey) {
+		return nil
+	}
+
+	return diff.ForceNew("engine")
+}
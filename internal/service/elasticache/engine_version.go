@@ -54,6 +54,24 @@ func validRedisVersionString(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+const (
+	valkeyVersionRegexpPattern = `^[7-9]\.[[:digit:]]+$`
+)
+
+var (
+	valkeyVersionRegexp = regexp.MustCompile(valkeyVersionRegexpPattern)
+)
+
+func validValkeyVersionString(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !valkeyVersionRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s: Valkey versions must match <major>.<minor>", k))
+	}
+
+	return
+}
+
 // CustomizeDiffValidateClusterEngineVersion validates the correct format for `engine_version`, based on `engine`
 func CustomizeDiffValidateClusterEngineVersion(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
 	engineVersion, ok := diff.GetOk("engine_version")
@@ -68,10 +86,14 @@ func CustomizeDiffValidateClusterEngineVersion(_ context.Context, diff *schema.R
 func validateClusterEngineVersion(engine, engineVersion string) error {
 	// Memcached: Versions in format <major>.<minor>.<patch>
 	// Redis: Starting with version 6, must match <major>.<minor>, prior to version 6, <major>.<minor>.<patch>
+	// Valkey: Versions in format <major>.<minor>
 	var validator schema.SchemaValidateFunc
-	if engine == "" || engine == engineMemcached {
+	switch engine {
+	case "", engineMemcached:
 		validator = validMemcachedVersionString
-	} else {
+	case engineValkey:
+		validator = validValkeyVersionString
+	default:
 		validator = validRedisVersionString
 	}
 
@@ -277,6 +277,27 @@ func TestValidateClusterEngineVersion(t *testing.T) {
 			version: "7.0",
 			valid:   true,
 		},
+
+		{
+			engine:  engineValkey,
+			version: "1.2.3",
+			valid:   false,
+		},
+		{
+			engine:  engineValkey,
+			version: "6.x",
+			valid:   false,
+		},
+		{
+			engine:  engineValkey,
+			version: "7.2",
+			valid:   true,
+		},
+		{
+			engine:  engineValkey,
+			version: "8.0",
+			valid:   true,
+		},
 	}
 
 	for _, testcase := range testcases {
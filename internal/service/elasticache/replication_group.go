@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	elasticache_sdkv2 "github.com/aws/aws-sdk-go-v2/service/elasticache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -102,15 +103,13 @@ func ResourceReplicationGroup() *schema.Resource {
 			"engine": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				Default:      engineRedis,
-				ValidateFunc: validation.StringInSlice([]string{engineRedis}, true),
+				ValidateFunc: validation.StringInSlice([]string{engineRedis, engineValkey}, true),
 			},
 			"engine_version": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validRedisVersionString,
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
 			},
 			"engine_version_actual": {
 				Type:     schema.TypeString,
@@ -345,6 +344,8 @@ func ResourceReplicationGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			CustomizeDiffValidateReplicationGroupAutomaticFailover,
+			CustomizeDiffValidateClusterEngineVersion,
+			customizeDiffReplicationGroupEngine,
 			customizeDiffEngineVersionForceNewOnDowngrade,
 			customdiff.ComputedIf("member_clusters", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("num_cache_clusters") ||
@@ -683,6 +684,35 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 
+		// Switching engines is only supported by the AWS SDK for Go v2.
+		if d.HasChange("engine") {
+			input := &elasticache_sdkv2.ModifyReplicationGroupInput{
+				ApplyImmediately:   aws.Bool(d.Get("apply_immediately").(bool)),
+				Engine:             aws.String(d.Get("engine").(string)),
+				ReplicationGroupId: aws.String(d.Id()),
+			}
+
+			// Without a configured engine_version, the computed value is the current
+			// Redis version, which is not a valid version for the new engine.
+			if v := d.GetRawConfig().GetAttr("engine_version"); v.IsKnown() && !v.IsNull() {
+				input.EngineVersion = aws.String(d.Get("engine_version").(string))
+			}
+
+			if d.HasChange("parameter_group_name") {
+				input.CacheParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+			}
+
+			_, err := meta.(*conns.AWSClient).ElastiCacheClient(ctx).ModifyReplicationGroup(ctx, input)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating ElastiCache Replication Group (%s) engine: %s", d.Id(), err)
+			}
+
+			_, err = WaitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Replication Group (%s) engine update: %s", d.Id(), err)
+			}
+		}
+
 		requestUpdate := false
 		input := &elasticache.ModifyReplicationGroupInput{
 			ApplyImmediately:   aws.Bool(d.Get("apply_immediately").(bool)),
@@ -760,12 +790,12 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			requestUpdate = true
 		}
 
-		if d.HasChange("parameter_group_name") {
+		if d.HasChange("parameter_group_name") && !d.HasChange("engine") {
 			input.CacheParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
 			requestUpdate = true
 		}
 
-		if d.HasChange("engine_version") {
+		if d.HasChange("engine_version") && !d.HasChange("engine") {
 			input.EngineVersion = aws.String(d.Get("engine_version").(string))
 			requestUpdate = true
 		}
@@ -0,0 +1,595 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_elasticache_serverless_cache", name="Serverless Cache")
+// @Tags(identifierAttribute="arn")
+func ResourceServerlessCache() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServerlessCacheCreate,
+		ReadWithoutTimeout:   resourceServerlessCacheRead,
+		UpdateWithoutTimeout: resourceServerlessCacheUpdate,
+		DeleteWithoutTimeout: resourceServerlessCacheDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Update: schema.DefaultTimeout(80 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cache_usage_limits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_storage": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"minimum": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"unit": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(elasticache.DataStorageUnit_Values(), false),
+									},
+								},
+							},
+						},
+						"ecpu_per_second": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"minimum": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"daily_snapshot_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"endpoint": serverlessCacheEndpointSchema(),
+			"engine": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(engine_Values(), false),
+			},
+			"full_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"major_engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"reader_endpoint": serverlessCacheEndpointSchema(),
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"snapshot_arns_to_restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"snapshot_retention_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtMost(35),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"user_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func serverlessCacheEndpointSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceServerlessCacheCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &elasticache.CreateServerlessCacheInput{
+		Engine:              aws.String(d.Get("engine").(string)),
+		ServerlessCacheName: aws.String(name),
+		Tags:                getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("cache_usage_limits"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CacheUsageLimits = expandCacheUsageLimits(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("daily_snapshot_time"); ok {
+		input.DailySnapshotTime = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("major_engine_version"); ok {
+		input.MajorEngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("snapshot_arns_to_restore"); ok && len(v.([]interface{})) > 0 {
+		input.SnapshotArnsToRestore = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("snapshot_retention_limit"); ok {
+		input.SnapshotRetentionLimit = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("subnet_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SubnetIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("user_group_id"); ok {
+		input.UserGroupId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateServerlessCacheWithContext(ctx, input)
+
+	// Some partitions (e.g. ISO) may not support tag-on-create.
+	if input.Tags != nil && errs.IsUnsupportedOperationInPartitionError(conn.PartitionID, err) {
+		input.Tags = nil
+
+		output, err = conn.CreateServerlessCacheWithContext(ctx, input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ElastiCache Serverless Cache (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ServerlessCache.ServerlessCacheName))
+
+	if _, err := waitServerlessCacheAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Serverless Cache (%s) create: %s", d.Id(), err)
+	}
+
+	// For partitions not supporting tag-on-create, attempt tag after create.
+	if tags := getTagsIn(ctx); input.Tags == nil && len(tags) > 0 {
+		err := createTags(ctx, conn, aws.StringValue(output.ServerlessCache.ARN), tags)
+
+		// If default tags only, continue. Otherwise, error.
+		if v, ok := d.GetOk(names.AttrTags); (!ok || len(v.(map[string]interface{})) == 0) && errs.IsUnsupportedOperationInPartitionError(conn.PartitionID, err) {
+			return append(diags, resourceServerlessCacheRead(ctx, d, meta)...)
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting ElastiCache Serverless Cache (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceServerlessCacheRead(ctx, d, meta)...)
+}
+
+func resourceServerlessCacheRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
+
+	cache, err := FindServerlessCacheByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ElastiCache Serverless Cache (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ElastiCache Serverless Cache (%s): %s", d.Id(), err)
+	}
+
+	if err := setServerlessCacheResourceData(d, cache); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ElastiCache Serverless Cache (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceServerlessCacheUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &elasticache.ModifyServerlessCacheInput{
+			ServerlessCacheName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("cache_usage_limits") {
+			if v, ok := d.GetOk("cache_usage_limits"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CacheUsageLimits = expandCacheUsageLimits(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("daily_snapshot_time") {
+			input.DailySnapshotTime = aws.String(d.Get("daily_snapshot_time").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("security_group_ids") {
+			input.SecurityGroupIds = flex.ExpandStringSet(d.Get("security_group_ids").(*schema.Set))
+		}
+
+		if d.HasChange("snapshot_retention_limit") {
+			input.SnapshotRetentionLimit = aws.Int64(int64(d.Get("snapshot_retention_limit").(int)))
+		}
+
+		if d.HasChange("user_group_id") {
+			if v, ok := d.GetOk("user_group_id"); ok {
+				input.UserGroupId = aws.String(v.(string))
+			} else {
+				input.RemoveUserGroup = aws.Bool(true)
+			}
+		}
+
+		_, err := conn.ModifyServerlessCacheWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ElastiCache Serverless Cache (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitServerlessCacheAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Serverless Cache (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceServerlessCacheRead(ctx, d, meta)...)
+}
+
+func resourceServerlessCacheDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
+
+	log.Printf("[INFO] Deleting ElastiCache Serverless Cache: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteServerlessCacheWithContext(ctx, &elasticache.DeleteServerlessCacheInput{
+			ServerlessCacheName: aws.String(d.Id()),
+		})
+	}, elasticache.ErrCodeInvalidServerlessCacheStateFault)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeServerlessCacheNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ElastiCache Serverless Cache (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitServerlessCacheDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Serverless Cache (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// setServerlessCacheResourceData sets the attributes shared by the resource and data source.
+func setServerlessCacheResourceData(d *schema.ResourceData, cache *elasticache.ServerlessCache) error {
+	d.Set("arn", cache.ARN)
+	if err := d.Set("cache_usage_limits", flattenCacheUsageLimits(cache.CacheUsageLimits)); err != nil {
+		return fmt.Errorf("setting cache_usage_limits: %w", err)
+	}
+	if cache.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(cache.CreateTime).Format(time.RFC3339))
+	}
+	d.Set("daily_snapshot_time", cache.DailySnapshotTime)
+	d.Set("description", cache.Description)
+	if err := d.Set("endpoint", flattenServerlessCacheEndpoint(cache.Endpoint)); err != nil {
+		return fmt.Errorf("setting endpoint: %w", err)
+	}
+	d.Set("engine", cache.Engine)
+	d.Set("full_engine_version", cache.FullEngineVersion)
+	d.Set("kms_key_id", cache.KmsKeyId)
+	d.Set("major_engine_version", cache.MajorEngineVersion)
+	d.Set("name", cache.ServerlessCacheName)
+	if err := d.Set("reader_endpoint", flattenServerlessCacheEndpoint(cache.ReaderEndpoint)); err != nil {
+		return fmt.Errorf("setting reader_endpoint: %w", err)
+	}
+	d.Set("security_group_ids", aws.StringValueSlice(cache.SecurityGroupIds))
+	d.Set("snapshot_retention_limit", cache.SnapshotRetentionLimit)
+	d.Set("status", cache.Status)
+	d.Set("subnet_ids", aws.StringValueSlice(cache.SubnetIds))
+	d.Set("user_group_id", cache.UserGroupId)
+
+	return nil
+}
+
+func FindServerlessCacheByName(ctx context.Context, conn *elasticache.ElastiCache, name string) (*elasticache.ServerlessCache, error) {
+	input := &elasticache.DescribeServerlessCachesInput{
+		ServerlessCacheName: aws.String(name),
+	}
+
+	output, err := conn.DescribeServerlessCachesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeServerlessCacheNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ServerlessCaches) == 0 || output.ServerlessCaches[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ServerlessCaches); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ServerlessCaches[0], nil
+}
+
+func statusServerlessCache(ctx context.Context, conn *elasticache.ElastiCache, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServerlessCacheByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+const (
+	serverlessCacheStatusAvailable    = "available"
+	serverlessCacheStatusCreateFailed = "create-failed"
+	serverlessCacheStatusCreating     = "creating"
+	serverlessCacheStatusDeleting     = "deleting"
+	serverlessCacheStatusModifying    = "modifying"
+)
+
+func waitServerlessCacheAvailable(ctx context.Context, conn *elasticache.ElastiCache, name string, timeout time.Duration) (*elasticache.ServerlessCache, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{serverlessCacheStatusCreating, serverlessCacheStatusModifying},
+		Target:     []string{serverlessCacheStatusAvailable},
+		Refresh:    statusServerlessCache(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*elasticache.ServerlessCache); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServerlessCacheDeleted(ctx context.Context, conn *elasticache.ElastiCache, name string, timeout time.Duration) (*elasticache.ServerlessCache, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{serverlessCacheStatusCreateFailed, serverlessCacheStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusServerlessCache(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*elasticache.ServerlessCache); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandCacheUsageLimits(tfMap map[string]interface{}) *elasticache.CacheUsageLimits {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &elasticache.CacheUsageLimits{}
+
+	if v, ok := tfMap["data_storage"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		dataStorage := &elasticache.DataStorage{
+			Unit: aws.String(tfMap["unit"].(string)),
+		}
+
+		if v, ok := tfMap["maximum"].(int); ok && v != 0 {
+			dataStorage.Maximum = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["minimum"].(int); ok && v != 0 {
+			dataStorage.Minimum = aws.Int64(int64(v))
+		}
+
+		apiObject.DataStorage = dataStorage
+	}
+
+	if v, ok := tfMap["ecpu_per_second"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		ecpuPerSecond := &elasticache.ECPUPerSecond{}
+
+		if v, ok := tfMap["maximum"].(int); ok && v != 0 {
+			ecpuPerSecond.Maximum = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["minimum"].(int); ok && v != 0 {
+			ecpuPerSecond.Minimum = aws.Int64(int64(v))
+		}
+
+		apiObject.ECPUPerSecond = ecpuPerSecond
+	}
+
+	return apiObject
+}
+
+func flattenCacheUsageLimits(apiObject *elasticache.CacheUsageLimits) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DataStorage; v != nil {
+		tfMap["data_storage"] = []interface{}{map[string]interface{}{
+			"maximum": aws.Int64Value(v.Maximum),
+			"minimum": aws.Int64Value(v.Minimum),
+			"unit":    aws.StringValue(v.Unit),
+		}}
+	}
+
+	if v := apiObject.ECPUPerSecond; v != nil {
+		tfMap["ecpu_per_second"] = []interface{}{map[string]interface{}{
+			"maximum": aws.Int64Value(v.Maximum),
+			"minimum": aws.Int64Value(v.Minimum),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenServerlessCacheEndpoint(apiObject *elasticache.Endpoint) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"address": aws.StringValue(apiObject.Address),
+		"port":    aws.Int64Value(apiObject.Port),
+	}}
+}
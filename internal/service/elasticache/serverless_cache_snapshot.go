@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_elasticache_serverless_cache_snapshot", name="Serverless Cache Snapshot")
+// @Tags(identifierAttribute="arn")
+func ResourceServerlessCacheSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServerlessCacheSnapshotCreate,
+		ReadWithoutTimeout:   resourceServerlessCacheSnapshotRead,
+		UpdateWithoutTimeout: resourceServerlessCacheSnapshotUpdate,
+		DeleteWithoutTimeout: resourceServerlessCacheSnapshotDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bytes_used_for_cache": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiry_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"serverless_cache_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"major_engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"serverless_cache_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"serverless_cache_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceServerlessCacheSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &elasticache.CreateServerlessCacheSnapshotInput{
+		ServerlessCacheName:         aws.String(d.Get("serverless_cache_name").(string)),
+		ServerlessCacheSnapshotName: aws.String(name),
+		Tags:                        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateServerlessCacheSnapshotWithContext(ctx, input)
+
+	// Some partitions (e.g. ISO) may not support tag-on-create.
+	if input.Tags != nil && errs.IsUnsupportedOperationInPartitionError(conn.PartitionID, err) {
+		input.Tags = nil
+
+		output, err = conn.CreateServerlessCacheSnapshotWithContext(ctx, input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ElastiCache Serverless Cache Snapshot (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ServerlessCacheSnapshot.ServerlessCacheSnapshotName))
+
+	if _, err := waitServerlessCacheSnapshotAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Serverless Cache Snapshot (%s) create: %s", d.Id(), err)
+	}
+
+	// For partitions not supporting tag-on-create, attempt tag after create.
+	if tags := getTagsIn(ctx); input.Tags == nil && len(tags) > 0 {
+		err := createTags(ctx, conn, aws.StringValue(output.ServerlessCacheSnapshot.ARN), tags)
+
+		// If default tags only, continue. Otherwise, error.
+		if v, ok := d.GetOk(names.AttrTags); (!ok || len(v.(map[string]interface{})) == 0) && errs.IsUnsupportedOperationInPartitionError(conn.PartitionID, err) {
+			return append(diags, resourceServerlessCacheSnapshotRead(ctx, d, meta)...)
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting ElastiCache Serverless Cache Snapshot (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceServerlessCacheSnapshotRead(ctx, d, meta)...)
+}
+
+func resourceServerlessCacheSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
+
+	snapshot, err := FindServerlessCacheSnapshotByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ElastiCache Serverless Cache Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ElastiCache Serverless Cache Snapshot (%s): %s", d.Id(), err)
+	}
+
+	if err := setServerlessCacheSnapshotResourceData(d, snapshot); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ElastiCache Serverless Cache Snapshot (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceServerlessCacheSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceServerlessCacheSnapshotRead(ctx, d, meta)...)
+}
+
+func resourceServerlessCacheSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
+
+	log.Printf("[INFO] Deleting ElastiCache Serverless Cache Snapshot: %s", d.Id())
+	_, err := conn.DeleteServerlessCacheSnapshotWithContext(ctx, &elasticache.DeleteServerlessCacheSnapshotInput{
+		ServerlessCacheSnapshotName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeServerlessCacheSnapshotNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ElastiCache Serverless Cache Snapshot (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitServerlessCacheSnapshotDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Serverless Cache Snapshot (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// setServerlessCacheSnapshotResourceData sets the attributes shared by the resource and data source.
+func setServerlessCacheSnapshotResourceData(d *schema.ResourceData, snapshot *elasticache.ServerlessCacheSnapshot) error {
+	d.Set("arn", snapshot.ARN)
+	d.Set("bytes_used_for_cache", snapshot.BytesUsedForCache)
+	if snapshot.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(snapshot.CreateTime).Format(time.RFC3339))
+	}
+	if snapshot.ExpiryTime != nil {
+		d.Set("expiry_time", aws.TimeValue(snapshot.ExpiryTime).Format(time.RFC3339))
+	}
+	d.Set("kms_key_id", snapshot.KmsKeyId)
+	d.Set("name", snapshot.ServerlessCacheSnapshotName)
+	if v := snapshot.ServerlessCacheConfiguration; v != nil {
+		if err := d.Set("serverless_cache_configuration", []interface{}{map[string]interface{}{
+			"engine":                aws.StringValue(v.Engine),
+			"major_engine_version":  aws.StringValue(v.MajorEngineVersion),
+			"serverless_cache_name": aws.StringValue(v.ServerlessCacheName),
+		}}); err != nil {
+			return fmt.Errorf("setting serverless_cache_configuration: %w", err)
+		}
+		d.Set("serverless_cache_name", v.ServerlessCacheName)
+	} else {
+		d.Set("serverless_cache_configuration", nil)
+	}
+	d.Set("snapshot_type", snapshot.SnapshotType)
+	d.Set("status", snapshot.Status)
+
+	return nil
+}
+
+func FindServerlessCacheSnapshotByName(ctx context.Context, conn *elasticache.ElastiCache, name string) (*elasticache.ServerlessCacheSnapshot, error) {
+	input := &elasticache.DescribeServerlessCacheSnapshotsInput{
+		ServerlessCacheSnapshotName: aws.String(name),
+	}
+
+	output, err := conn.DescribeServerlessCacheSnapshotsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeServerlessCacheSnapshotNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ServerlessCacheSnapshots) == 0 || output.ServerlessCacheSnapshots[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ServerlessCacheSnapshots); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ServerlessCacheSnapshots[0], nil
+}
+
+func statusServerlessCacheSnapshot(ctx context.Context, conn *elasticache.ElastiCache, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServerlessCacheSnapshotByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+const (
+	serverlessCacheSnapshotStatusAvailable = "available"
+	serverlessCacheSnapshotStatusCreating  = "creating"
+	serverlessCacheSnapshotStatusDeleting  = "deleting"
+)
+
+func waitServerlessCacheSnapshotAvailable(ctx context.Context, conn *elasticache.ElastiCache, name string, timeout time.Duration) (*elasticache.ServerlessCacheSnapshot, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{serverlessCacheSnapshotStatusCreating},
+		Target:     []string{serverlessCacheSnapshotStatusAvailable},
+		Refresh:    statusServerlessCacheSnapshot(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*elasticache.ServerlessCacheSnapshot); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServerlessCacheSnapshotDeleted(ctx context.Context, conn *elasticache.ElastiCache, name string, timeout time.Duration) (*elasticache.ServerlessCacheSnapshot, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{serverlessCacheSnapshotStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusServerlessCacheSnapshot(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*elasticache.ServerlessCacheSnapshot); ok {
+		return output, err
+	}
+
+	return nil, err
+}
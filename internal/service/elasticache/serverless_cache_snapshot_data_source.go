@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_elasticache_serverless_cache_snapshot")
+func DataSourceServerlessCacheSnapshot() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServerlessCacheSnapshotRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bytes_used_for_cache": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiry_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"serverless_cache_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"major_engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"serverless_cache_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"serverless_cache_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceServerlessCacheSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+	snapshot, err := FindServerlessCacheSnapshotByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "Your query returned no results. Please change your search criteria and try again")
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ElastiCache Serverless Cache Snapshot (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(snapshot.ServerlessCacheSnapshotName))
+
+	if err := setServerlessCacheSnapshotResourceData(d, snapshot); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ElastiCache Serverless Cache Snapshot (%s): %s", name, err)
+	}
+
+	tags, err := listTags(ctx, conn, aws.StringValue(snapshot.ARN))
+
+	if err != nil && !verify.ErrorISOUnsupported(conn.PartitionID, err) {
+		return sdkdiag.AppendErrorf(diags, "listing tags for ElastiCache Serverless Cache Snapshot (%s): %s", d.Id(), err)
+	}
+
+	if err != nil {
+		log.Printf("[WARN] error listing tags for ElastiCache Serverless Cache Snapshot (%s): %s", d.Id(), err)
+	}
+
+	if tags != nil {
+		if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+		}
+	}
+
+	return diags
+}
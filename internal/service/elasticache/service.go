@@ -6,6 +6,7 @@ package elasticache
 const (
 	engineMemcached = "memcached"
 	engineRedis     = "redis"
+	engineValkey    = "valkey"
 )
 
 // engine_Values returns all elements of the Engine enum
@@ -13,5 +14,6 @@ func engine_Values() []string {
 	return []string{
 		engineMemcached,
 		engineRedis,
+		engineValkey,
 	}
 }
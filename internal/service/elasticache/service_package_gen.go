@@ -5,6 +5,8 @@ package elasticache
 import (
 	"context"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	elasticache_sdkv2 "github.com/aws/aws-sdk-go-v2/service/elasticache"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	elasticache_sdkv1 "github.com/aws/aws-sdk-go/service/elasticache"
@@ -33,6 +35,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceReplicationGroup,
 			TypeName: "aws_elasticache_replication_group",
 		},
+		{
+			Factory:  DataSourceServerlessCache,
+			TypeName: "aws_elasticache_serverless_cache",
+		},
+		{
+			Factory:  DataSourceServerlessCacheSnapshot,
+			TypeName: "aws_elasticache_serverless_cache_snapshot",
+		},
 		{
 			Factory:  DataSourceSubnetGroup,
 			TypeName: "aws_elasticache_subnet_group",
@@ -74,6 +84,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceServerlessCache,
+			TypeName: "aws_elasticache_serverless_cache",
+			Name:     "Serverless Cache",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceServerlessCacheSnapshot,
+			TypeName: "aws_elasticache_serverless_cache_snapshot",
+			Name:     "Serverless Cache Snapshot",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceSubnetGroup,
 			TypeName: "aws_elasticache_subnet_group",
@@ -116,6 +142,17 @@ func (p *servicePackage) NewConn(ctx context.Context, config map[string]any) (*e
 	return elasticache_sdkv1.New(sess.Copy(&aws_sdkv1.Config{Endpoint: aws_sdkv1.String(config["endpoint"].(string))})), nil
 }
 
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*elasticache_sdkv2.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
+
+	return elasticache_sdkv2.NewFromConfig(cfg, func(o *elasticache_sdkv2.Options) {
+		if endpoint := config["endpoint"].(string); endpoint != "" {
+			o.EndpointResolver = elasticache_sdkv2.EndpointResolverFromURL(endpoint)
+		}
+	}), nil
+}
+
 func ServicePackage(ctx context.Context) conns.ServicePackage {
 	return &servicePackage{}
 }
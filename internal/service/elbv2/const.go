@@ -8,3 +8,17 @@ const (
 
 	TagsOnCreationErrMessage = "cannot specify tags on creation"
 )
+
+const (
+	mutualAuthenticationModeOff         = "off"
+	mutualAuthenticationModePassthrough = "passthrough"
+	mutualAuthenticationModeVerify      = "verify"
+)
+
+func mutualAuthenticationMode_Values() []string {
+	return []string{
+		mutualAuthenticationModeOff,
+		mutualAuthenticationModePassthrough,
+		mutualAuthenticationModeVerify,
+	}
+}
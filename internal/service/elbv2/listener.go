@@ -52,6 +52,7 @@ func ResourceListener() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			customizeDiffListenerMutualAuthentication,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -355,6 +356,29 @@ func ResourceListener() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"mutual_authentication": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ignore_client_certificate_expiry": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mutualAuthenticationMode_Values(), false),
+						},
+						"trust_store_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			"port": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -395,6 +419,32 @@ func suppressIfDefaultActionTypeNot(t string) schema.SchemaDiffSuppressFunc {
 	}
 }
 
+func customizeDiffListenerMutualAuthentication(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("mutual_authentication")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	mode := tfMap["mode"].(string)
+
+	if mode == mutualAuthenticationModeOff {
+		return nil
+	}
+
+	if diff.NewValueKnown("protocol") {
+		if protocol := diff.Get("protocol").(string); !strings.EqualFold(protocol, elbv2.ProtocolEnumHttps) {
+			return fmt.Errorf("mutual_authentication mode %q requires protocol %q, got %q", mode, elbv2.ProtocolEnumHttps, protocol)
+		}
+	}
+
+	if mode == mutualAuthenticationModeVerify && diff.NewValueKnown("mutual_authentication.0.trust_store_arn") && tfMap["trust_store_arn"].(string) == "" {
+		return fmt.Errorf("mutual_authentication mode %q requires trust_store_arn", mode)
+	}
+
+	return nil
+}
+
 func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
@@ -425,6 +475,12 @@ func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("mutual_authentication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if v := expandMutualAuthenticationAttributes(v.([]interface{})[0].(map[string]interface{})); aws.StringValue(v.Mode) != mutualAuthenticationModeOff {
+			input.MutualAuthentication = v
+		}
+	}
+
 	if v, ok := d.GetOk("port"); ok {
 		input.Port = aws.Int64(int64(v.(int)))
 	}
@@ -529,6 +585,14 @@ func resourceListenerRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set("arn", listener.ListenerArn)
 	d.Set("load_balancer_arn", listener.LoadBalancerArn)
+	// HTTPS listeners report a mode of "off" when mutual TLS has never been configured.
+	if v := listener.MutualAuthentication; v != nil && (aws.StringValue(v.Mode) != mutualAuthenticationModeOff || len(d.Get("mutual_authentication").([]interface{})) > 0) {
+		if err := d.Set("mutual_authentication", []interface{}{flattenMutualAuthenticationAttributes(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting mutual_authentication: %s", err)
+		}
+	} else {
+		d.Set("mutual_authentication", nil)
+	}
 	d.Set("port", listener.Port)
 	d.Set("protocol", listener.Protocol)
 	d.Set("ssl_policy", listener.SslPolicy)
@@ -595,6 +659,16 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
+		if d.HasChange("mutual_authentication") {
+			if v, ok := d.GetOk("mutual_authentication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.MutualAuthentication = expandMutualAuthenticationAttributes(v.([]interface{})[0].(map[string]interface{}))
+			} else if strings.EqualFold(d.Get("protocol").(string), elbv2.ProtocolEnumHttps) {
+				input.MutualAuthentication = &elbv2.MutualAuthenticationAttributes{
+					Mode: aws.String(mutualAuthenticationModeOff),
+				}
+			}
+		}
+
 		err := retry.RetryContext(ctx, loadBalancerListenerUpdateTimeout, func() *retry.RetryError {
 			_, err := conn.ModifyListenerWithContext(ctx, input)
 
@@ -672,6 +746,45 @@ func retryListenerCreate(ctx context.Context, conn *elbv2.ELBV2, params *elbv2.C
 	return output, nil
 }
 
+func expandMutualAuthenticationAttributes(tfMap map[string]interface{}) *elbv2.MutualAuthenticationAttributes {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &elbv2.MutualAuthenticationAttributes{}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Mode = aws.String(v)
+	}
+
+	// The trust store and certificate expiry settings only apply in verify mode.
+	if aws.StringValue(apiObject.Mode) == mutualAuthenticationModeVerify {
+		if v, ok := tfMap["ignore_client_certificate_expiry"].(bool); ok {
+			apiObject.IgnoreClientCertificateExpiry = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["trust_store_arn"].(string); ok && v != "" {
+			apiObject.TrustStoreArn = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenMutualAuthenticationAttributes(apiObject *elbv2.MutualAuthenticationAttributes) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ignore_client_certificate_expiry": aws.BoolValue(apiObject.IgnoreClientCertificateExpiry),
+		"mode":                             aws.StringValue(apiObject.Mode),
+		"trust_store_arn":                  aws.StringValue(apiObject.TrustStoreArn),
+	}
+
+	return tfMap
+}
+
 func expandLbListenerActions(l []interface{}) ([]*elbv2.Action, error) {
 	if len(l) == 0 {
 		return nil, nil
@@ -257,6 +257,26 @@ func DataSourceListener() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{"arn"},
 			},
+			"mutual_authentication": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ignore_client_certificate_expiry": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trust_store_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"port": {
 				Type:          schema.TypeInt,
 				Optional:      true,
@@ -331,6 +351,13 @@ func dataSourceListenerRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.SetId(aws.StringValue(listener.ListenerArn))
 	d.Set("arn", listener.ListenerArn)
 	d.Set("load_balancer_arn", listener.LoadBalancerArn)
+	if listener.MutualAuthentication != nil {
+		if err := d.Set("mutual_authentication", []interface{}{flattenMutualAuthenticationAttributes(listener.MutualAuthentication)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting mutual_authentication: %s", err)
+		}
+	} else {
+		d.Set("mutual_authentication", nil)
+	}
 	d.Set("port", listener.Port)
 	d.Set("protocol", listener.Protocol)
 	d.Set("ssl_policy", listener.SslPolicy)
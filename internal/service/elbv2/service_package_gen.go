@@ -53,6 +53,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceTargetGroup,
 			TypeName: "aws_lb_target_group",
 		},
+		{
+			Factory:  DataSourceTrustStore,
+			TypeName: "aws_lb_trust_store",
+		},
 		{
 			Factory:  DataSourceLoadBalancers,
 			TypeName: "aws_lbs",
@@ -142,6 +146,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceTargetGroupAttachment,
 			TypeName: "aws_lb_target_group_attachment",
 		},
+		{
+			Factory:  ResourceTrustStore,
+			TypeName: "aws_lb_trust_store",
+			Name:     "Trust Store",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceTrustStoreRevocation,
+			TypeName: "aws_lb_trust_store_revocation",
+			Name:     "Trust Store Revocation",
+		},
 	}
 }
 
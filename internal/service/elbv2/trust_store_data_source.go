@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_lb_trust_store")
+func DataSourceTrustStore() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTrustStoreRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  verify.ValidARN,
+				ConflictsWith: []string{"name"},
+			},
+			"arn_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"arn"},
+			},
+			"number_of_ca_certificates": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_revoked_entries": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTrustStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
+
+	input := &elbv2.DescribeTrustStoresInput{}
+
+	if v, ok := d.GetOk("arn"); ok {
+		input.TrustStoreArns = aws.StringSlice([]string{v.(string)})
+	} else if v, ok := d.GetOk("name"); ok {
+		input.Names = aws.StringSlice([]string{v.(string)})
+	}
+
+	trustStore, err := FindTrustStore(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("ELBv2 Trust Store", err))
+	}
+
+	d.SetId(aws.StringValue(trustStore.TrustStoreArn))
+	d.Set("arn", trustStore.TrustStoreArn)
+	d.Set("arn_suffix", TrustStoreSuffixFromARN(trustStore.TrustStoreArn))
+	d.Set("name", trustStore.Name)
+	d.Set("number_of_ca_certificates", trustStore.NumberOfCaCertificates)
+	d.Set("status", trustStore.Status)
+	d.Set("total_revoked_entries", trustStore.TotalRevokedEntries)
+
+	return diags
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccELBV2TrustStoreDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_trust_store.test"
+	dataSourceNameByARN := "data.aws_lb_trust_store.by_arn"
+	dataSourceNameByName := "data.aws_lb_trust_store.by_name"
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, caKey)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreDataSourceConfig_basic(rName, caCertificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceNameByARN, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByARN, "arn_suffix", resourceName, "arn_suffix"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByARN, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceNameByARN, "number_of_ca_certificates", "1"),
+					resource.TestCheckResourceAttr(dataSourceNameByARN, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByName, "name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTrustStoreDataSourceConfig_basic(rName, caCertificate string) string {
+	return acctest.ConfigCompose(testAccTrustStoreConfig_basic(rName, caCertificate), `
+data "aws_lb_trust_store" "by_arn" {
+  arn = aws_lb_trust_store.test.arn
+}
+
+data "aws_lb_trust_store" "by_name" {
+  name = aws_lb_trust_store.test.name
+}
+`)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelbv2 "github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccELBV2TrustStoreRevocation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf elbv2.DescribeTrustStoreRevocation
+	resourceName := "aws_lb_trust_store_revocation.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, caKey)
+	crl := acctest.TLSRSAX509CertificateRevocationListPEM(t, caKey, caCertificate)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreRevocationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreRevocationConfig_basic(rName, caCertificate, crl),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreRevocationExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrSet(resourceName, "revocation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "revocations_s3_bucket", "aws_s3_object.crl", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "revocations_s3_key", "aws_s3_object.crl", "key"),
+					resource.TestCheckResourceAttrPair(resourceName, "trust_store_arn", "aws_lb_trust_store.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"revocations_s3_bucket",
+					"revocations_s3_key",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckTrustStoreRevocationExists(ctx context.Context, n string, v *elbv2.DescribeTrustStoreRevocation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		revocationID, err := strconv.ParseInt(rs.Primary.Attributes["revocation_id"], 10, 64)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn(ctx)
+
+		output, err := tfelbv2.FindTrustStoreRevocationByTwoPartKey(ctx, conn, rs.Primary.Attributes["trust_store_arn"], revocationID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTrustStoreRevocationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lb_trust_store_revocation" {
+				continue
+			}
+
+			revocationID, err := strconv.ParseInt(rs.Primary.Attributes["revocation_id"], 10, 64)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfelbv2.FindTrustStoreRevocationByTwoPartKey(ctx, conn, rs.Primary.Attributes["trust_store_arn"], revocationID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ELBv2 Trust Store Revocation %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTrustStoreRevocationConfig_basic(rName, caCertificate, crl string) string {
+	return acctest.ConfigCompose(testAccTrustStoreConfig_basic(rName, caCertificate), fmt.Sprintf(`
+resource "aws_s3_object" "crl" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[1]s.crl"
+  content = "%[2]s"
+}
+
+resource "aws_lb_trust_store_revocation" "test" {
+  trust_store_arn       = aws_lb_trust_store.test.arn
+  revocations_s3_bucket = aws_s3_object.crl.bucket
+  revocations_s3_key    = aws_s3_object.crl.key
+}
+`, rName, acctest.TLSPEMEscapeNewlines(crl)))
+}
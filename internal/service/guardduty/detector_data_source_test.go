@@ -50,6 +50,7 @@ func testAccDetectorDataSource_ID(t *testing.T) {
 					resource.TestCheckResourceAttr("data.aws_guardduty_detector.test", "status", "ENABLED"),
 					acctest.CheckResourceAttrGlobalARN("data.aws_guardduty_detector.test", "service_role_arn", "iam", "role/aws-service-role/guardduty.amazonaws.com/AWSServiceRoleForAmazonGuardDuty"),
 					resource.TestCheckResourceAttrPair("data.aws_guardduty_detector.test", "finding_publishing_frequency", "aws_guardduty_detector.test", "finding_publishing_frequency"),
+					resource.TestCheckResourceAttrSet("data.aws_guardduty_detector.test", "features.#"),
 				),
 			},
 		},
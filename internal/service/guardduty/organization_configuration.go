@@ -48,10 +48,11 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 			},
 
 			"datasources": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
+				Type:       schema.TypeList,
+				Optional:   true,
+				Computed:   true,
+				MaxItems:   1,
+				Deprecated: "Use \"aws_guardduty_organization_configuration_feature\" resources instead",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"s3_logs": {
@@ -27,6 +27,9 @@ const (
 	// Reference error message:
 	// BadRequestException: The request is rejected because the current account cannot delete detector while it has invited or associated members.
 	membershipPropagationTimeout = 2 * time.Minute
+
+	// Maximum amount of time to wait for IAM changes to propagate
+	propagationTimeout = 2 * time.Minute
 )
 
 // waitAdminAccountEnabled waits for an AdminAccount to return Enabled
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+// Exports for use in tests only.
+var (
+	ReadGroupPolicyAttachments = readGroupPolicyAttachments
+	ReadGroupPolicyNames       = readGroupPolicyNames
+	ReadRolePolicyAttachments  = readRolePolicyAttachments
+	ReadRolePolicyNames        = readRolePolicyNames
+	ReadUserPolicyAttachments  = readUserPolicyAttachments
+	ReadUserPolicyNames        = readUserPolicyNames
+)
@@ -16,3 +16,19 @@ func expandStringListKeepEmpty(configured []interface{}) []*string {
 	}
 	return vs
 }
+
+// stringsNotIn returns the values in s which are not in values.
+func stringsNotIn(s []*string, values []string) []*string {
+	m := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		m[v] = struct{}{}
+	}
+
+	var vs []*string
+	for _, v := range s {
+		if _, ok := m[aws.StringValue(v)]; !ok {
+			vs = append(vs, v)
+		}
+	}
+	return vs
+}
@@ -184,29 +184,55 @@ func FindGroupByName(ctx context.Context, conn *iam.IAM, name string) (*iam.Grou
 }
 
 func DeleteGroupPolicyAttachments(ctx context.Context, conn *iam.IAM, groupName string) error {
-	var attachedPolicies []*iam.AttachedPolicy
+	policyARNs, err := readGroupPolicyAttachments(ctx, conn, groupName)
+
+	if err != nil {
+		return fmt.Errorf("listing IAM Group (%s) policy attachments for deletion: %w", groupName, err)
+	}
+
+	return deleteGroupPolicyAttachments(ctx, conn, groupName, policyARNs)
+}
+
+func DeleteGroupPolicies(ctx context.Context, conn *iam.IAM, groupName string) error {
+	policyNames, err := readGroupPolicyNames(ctx, conn, groupName)
+
+	if err != nil {
+		return fmt.Errorf("listing IAM Group (%s) inline policies for deletion: %w", groupName, err)
+	}
+
+	return deleteGroupInlinePolicies(ctx, conn, groupName, policyNames)
+}
+
+func readGroupPolicyAttachments(ctx context.Context, conn *iam.IAM, groupName string) ([]*string, error) {
+	var policyARNs []*string
 	input := &iam.ListAttachedGroupPoliciesInput{
 		GroupName: aws.String(groupName),
 	}
 
 	err := conn.ListAttachedGroupPoliciesPagesWithContext(ctx, input, func(page *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
-		attachedPolicies = append(attachedPolicies, page.AttachedPolicies...)
+		for _, v := range page.AttachedPolicies {
+			policyARNs = append(policyARNs, v.PolicyArn)
+		}
 
 		return !lastPage
 	})
 
 	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-		return nil
+		return nil, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("listing IAM Group (%s) policy attachments for deletion: %w", groupName, err)
+		return nil, err
 	}
 
-	for _, attachedPolicy := range attachedPolicies {
+	return policyARNs, nil
+}
+
+func deleteGroupPolicyAttachments(ctx context.Context, conn *iam.IAM, groupName string, policyARNs []*string) error {
+	for _, policyARN := range policyARNs {
 		input := &iam.DetachGroupPolicyInput{
 			GroupName: aws.String(groupName),
-			PolicyArn: attachedPolicy.PolicyArn,
+			PolicyArn: policyARN,
 		}
 
 		_, err := conn.DetachGroupPolicyWithContext(ctx, input)
@@ -216,33 +242,37 @@ func DeleteGroupPolicyAttachments(ctx context.Context, conn *iam.IAM, groupName
 		}
 
 		if err != nil {
-			return fmt.Errorf("detaching IAM Group (%s) policy (%s): %w", groupName, aws.StringValue(attachedPolicy.PolicyArn), err)
+			return fmt.Errorf("detaching IAM Group (%s) policy (%s): %w", groupName, aws.StringValue(policyARN), err)
 		}
 	}
 
 	return nil
 }
 
-func DeleteGroupPolicies(ctx context.Context, conn *iam.IAM, groupName string) error {
-	var inlinePolicies []*string
+func readGroupPolicyNames(ctx context.Context, conn *iam.IAM, groupName string) ([]*string, error) {
+	var policyNames []*string
 	input := &iam.ListGroupPoliciesInput{
 		GroupName: aws.String(groupName),
 	}
 
 	err := conn.ListGroupPoliciesPagesWithContext(ctx, input, func(page *iam.ListGroupPoliciesOutput, lastPage bool) bool {
-		inlinePolicies = append(inlinePolicies, page.PolicyNames...)
+		policyNames = append(policyNames, page.PolicyNames...)
 		return !lastPage
 	})
 
 	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-		return nil
+		return nil, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("listing IAM Group (%s) inline policies for deletion: %w", groupName, err)
+		return nil, err
 	}
 
-	for _, policyName := range inlinePolicies {
+	return policyNames, nil
+}
+
+func deleteGroupInlinePolicies(ctx context.Context, conn *iam.IAM, groupName string, policyNames []*string) error {
+	for _, policyName := range policyNames {
 		input := &iam.DeleteGroupPolicyInput{
 			GroupName:  aws.String(groupName),
 			PolicyName: policyName,
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_iam_group_policies_exclusive", name="Group Policies Exclusive")
+func ResourceGroupPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupPoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceGroupPoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceGroupPoliciesExclusivePut,
+		// Deleting this resource only stops exclusive management. The inline
+		// policies themselves are left in place.
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceGroupPoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	groupName := d.Get("group_name").(string)
+	policyNames := flex.ExpandStringValueSet(d.Get("policy_names").(*schema.Set))
+
+	if err := syncGroupPoliciesExclusive(ctx, conn, groupName, policyNames); err != nil {
+		return sdkdiag.AppendErrorf(diags, "synchronizing IAM Group (%s) inline policies: %s", groupName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(groupName)
+	}
+
+	return append(diags, resourceGroupPoliciesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceGroupPoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	_, err := FindGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group Policies Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group Policies Exclusive (%s): %s", d.Id(), err)
+	}
+
+	policyNames, err := readGroupPolicyNames(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group Policies Exclusive (%s) inline policies: %s", d.Id(), err)
+	}
+
+	d.Set("group_name", d.Id())
+	d.Set("policy_names", aws.StringValueSlice(policyNames))
+
+	return diags
+}
+
+// syncGroupPoliciesExclusive deletes any inline policies embedded in the group
+// which are not in the configured set of policy names.
+func syncGroupPoliciesExclusive(ctx context.Context, conn *iam.IAM, groupName string, want []string) error {
+	have, err := readGroupPolicyNames(ctx, conn, groupName)
+
+	if err != nil {
+		return err
+	}
+
+	return deleteGroupInlinePolicies(ctx, conn, groupName, stringsNotIn(have, want))
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_iam_group_policy_attachments_exclusive", name="Group Policy Attachments Exclusive")
+func ResourceGroupPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupPolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceGroupPolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceGroupPolicyAttachmentsExclusivePut,
+		// Deleting this resource only stops exclusive management. The managed
+		// policies remain attached.
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGroupPolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	groupName := d.Get("group_name").(string)
+	policyARNs := flex.ExpandStringValueSet(d.Get("policy_arns").(*schema.Set))
+
+	if err := syncGroupPolicyAttachmentsExclusive(ctx, conn, groupName, policyARNs); err != nil {
+		return sdkdiag.AppendErrorf(diags, "synchronizing IAM Group (%s) policy attachments: %s", groupName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(groupName)
+	}
+
+	return append(diags, resourceGroupPolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceGroupPolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	_, err := FindGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group Policy Attachments Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group Policy Attachments Exclusive (%s): %s", d.Id(), err)
+	}
+
+	policyARNs, err := readGroupPolicyAttachments(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group Policy Attachments Exclusive (%s) attached policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", aws.StringValueSlice(policyARNs))
+	d.Set("group_name", d.Id())
+
+	return diags
+}
+
+// syncGroupPolicyAttachmentsExclusive detaches any managed policies attached to
+// the group which are not in the configured set of policy ARNs.
+func syncGroupPolicyAttachmentsExclusive(ctx context.Context, conn *iam.IAM, groupName string, want []string) error {
+	have, err := readGroupPolicyAttachments(ctx, conn, groupName)
+
+	if err != nil {
+		return err
+	}
+
+	return deleteGroupPolicyAttachments(ctx, conn, groupName, stringsNotIn(have, want))
+}
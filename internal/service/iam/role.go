@@ -727,7 +727,7 @@ func deleteRoleInlinePolicies(ctx context.Context, conn *iam.IAM, roleName strin
 
 		_, err := conn.DeleteRolePolicyWithContext(ctx, input)
 		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			return nil
+			continue
 		}
 		if err != nil {
 			return fmt.Errorf("removing inline policy (%s): %w", aws.StringValue(name), err)
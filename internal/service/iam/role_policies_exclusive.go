@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_iam_role_policies_exclusive", name="Role Policies Exclusive")
+func ResourceRolePoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRolePoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceRolePoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePoliciesExclusivePut,
+		// Deleting this resource only stops exclusive management. The inline
+		// policies themselves are left in place.
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceRolePoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	roleName := d.Get("role_name").(string)
+	policyNames := flex.ExpandStringValueSet(d.Get("policy_names").(*schema.Set))
+
+	if err := syncRolePoliciesExclusive(ctx, conn, roleName, policyNames); err != nil {
+		return sdkdiag.AppendErrorf(diags, "synchronizing IAM Role (%s) inline policies: %s", roleName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(roleName)
+	}
+
+	return append(diags, resourceRolePoliciesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRolePoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	_, err := FindRoleByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role Policies Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role Policies Exclusive (%s): %s", d.Id(), err)
+	}
+
+	policyNames, err := readRolePolicyNames(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role Policies Exclusive (%s) inline policies: %s", d.Id(), err)
+	}
+
+	d.Set("role_name", d.Id())
+	d.Set("policy_names", aws.StringValueSlice(policyNames))
+
+	return diags
+}
+
+// syncRolePoliciesExclusive deletes any inline policies embedded in the role
+// which are not in the configured set of policy names.
+func syncRolePoliciesExclusive(ctx context.Context, conn *iam.IAM, roleName string, want []string) error {
+	have, err := readRolePolicyNames(ctx, conn, roleName)
+
+	if err != nil {
+		return err
+	}
+
+	return deleteRoleInlinePolicies(ctx, conn, roleName, stringsNotIn(have, want))
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_iam_role_policy_attachments_exclusive", name="Role Policy Attachments Exclusive")
+func ResourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceRolePolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		// Deleting this resource only stops exclusive management. The managed
+		// policies remain attached.
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	roleName := d.Get("role_name").(string)
+	policyARNs := flex.ExpandStringValueSet(d.Get("policy_arns").(*schema.Set))
+
+	if err := syncRolePolicyAttachmentsExclusive(ctx, conn, roleName, policyARNs); err != nil {
+		return sdkdiag.AppendErrorf(diags, "synchronizing IAM Role (%s) policy attachments: %s", roleName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(roleName)
+	}
+
+	return append(diags, resourceRolePolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRolePolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	_, err := FindRoleByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role Policy Attachments Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role Policy Attachments Exclusive (%s): %s", d.Id(), err)
+	}
+
+	policyARNs, err := readRolePolicyAttachments(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role Policy Attachments Exclusive (%s) attached policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", aws.StringValueSlice(policyARNs))
+	d.Set("role_name", d.Id())
+
+	return diags
+}
+
+// syncRolePolicyAttachmentsExclusive detaches any managed policies attached to
+// the role which are not in the configured set of policy ARNs.
+func syncRolePolicyAttachmentsExclusive(ctx context.Context, conn *iam.IAM, roleName string, want []string) error {
+	have, err := readRolePolicyAttachments(ctx, conn, roleName)
+
+	if err != nil {
+		return err
+	}
+
+	return deleteRolePolicyAttachments(ctx, conn, roleName, stringsNotIn(have, want))
+}
@@ -118,6 +118,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGroupMembership,
 			TypeName: "aws_iam_group_membership",
 		},
+		{
+			Factory:  ResourceGroupPoliciesExclusive,
+			TypeName: "aws_iam_group_policies_exclusive",
+			Name:     "Group Policies Exclusive",
+		},
 		{
 			Factory:  ResourceGroupPolicy,
 			TypeName: "aws_iam_group_policy",
@@ -126,6 +131,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGroupPolicyAttachment,
 			TypeName: "aws_iam_group_policy_attachment",
 		},
+		{
+			Factory:  ResourceGroupPolicyAttachmentsExclusive,
+			TypeName: "aws_iam_group_policy_attachments_exclusive",
+			Name:     "Group Policy Attachments Exclusive",
+		},
 		{
 			Factory:  ResourceInstanceProfile,
 			TypeName: "aws_iam_instance_profile",
@@ -154,6 +164,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Role",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceRolePoliciesExclusive,
+			TypeName: "aws_iam_role_policies_exclusive",
+			Name:     "Role Policies Exclusive",
+		},
 		{
 			Factory:  ResourceRolePolicy,
 			TypeName: "aws_iam_role_policy",
@@ -162,6 +177,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceRolePolicyAttachment,
 			TypeName: "aws_iam_role_policy_attachment",
 		},
+		{
+			Factory:  ResourceRolePolicyAttachmentsExclusive,
+			TypeName: "aws_iam_role_policy_attachments_exclusive",
+			Name:     "Role Policy Attachments Exclusive",
+		},
 		{
 			Factory:  ResourceSAMLProvider,
 			TypeName: "aws_iam_saml_provider",
@@ -207,6 +227,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceUserLoginProfile,
 			TypeName: "aws_iam_user_login_profile",
 		},
+		{
+			Factory:  ResourceUserPoliciesExclusive,
+			TypeName: "aws_iam_user_policies_exclusive",
+			Name:     "User Policies Exclusive",
+		},
 		{
 			Factory:  ResourceUserPolicy,
 			TypeName: "aws_iam_user_policy",
@@ -215,6 +240,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceUserPolicyAttachment,
 			TypeName: "aws_iam_user_policy_attachment",
 		},
+		{
+			Factory:  ResourceUserPolicyAttachmentsExclusive,
+			TypeName: "aws_iam_user_policy_attachments_exclusive",
+			Name:     "User Policy Attachments Exclusive",
+		},
 		{
 			Factory:  ResourceUserSSHKey,
 			TypeName: "aws_iam_user_ssh_key",
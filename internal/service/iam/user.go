@@ -536,3 +536,92 @@ func DeleteServiceSpecificCredentials(ctx context.Context, conn *iam.IAM, userna
 
 	return nil
 }
+
+func readUserPolicyAttachments(ctx context.Context, conn *iam.IAM, userName string) ([]*string, error) {
+	var policyARNs []*string
+	input := &iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+
+	err := conn.ListAttachedUserPoliciesPagesWithContext(ctx, input, func(page *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
+		for _, v := range page.AttachedPolicies {
+			policyARNs = append(policyARNs, v.PolicyArn)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return policyARNs, nil
+}
+
+func deleteUserPolicyAttachments(ctx context.Context, conn *iam.IAM, userName string, policyARNs []*string) error {
+	for _, policyARN := range policyARNs {
+		input := &iam.DetachUserPolicyInput{
+			PolicyArn: policyARN,
+			UserName:  aws.String(userName),
+		}
+
+		_, err := conn.DetachUserPolicyWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("detaching IAM User (%s) policy (%s): %w", userName, aws.StringValue(policyARN), err)
+		}
+	}
+
+	return nil
+}
+
+func readUserPolicyNames(ctx context.Context, conn *iam.IAM, userName string) ([]*string, error) {
+	var policyNames []*string
+	input := &iam.ListUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+
+	err := conn.ListUserPoliciesPagesWithContext(ctx, input, func(page *iam.ListUserPoliciesOutput, lastPage bool) bool {
+		policyNames = append(policyNames, page.PolicyNames...)
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return policyNames, nil
+}
+
+func deleteUserInlinePolicies(ctx context.Context, conn *iam.IAM, userName string, policyNames []*string) error {
+	for _, policyName := range policyNames {
+		input := &iam.DeleteUserPolicyInput{
+			PolicyName: policyName,
+			UserName:   aws.String(userName),
+		}
+
+		_, err := conn.DeleteUserPolicyWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting IAM User (%s) inline policy (%s): %w", userName, aws.StringValue(policyName), err)
+		}
+	}
+
+	return nil
+}
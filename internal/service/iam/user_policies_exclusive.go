@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_iam_user_policies_exclusive", name="User Policies Exclusive")
+func ResourceUserPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserPoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceUserPoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceUserPoliciesExclusivePut,
+		// Deleting this resource only stops exclusive management. The inline
+		// policies themselves are left in place.
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceUserPoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	userName := d.Get("user_name").(string)
+	policyNames := flex.ExpandStringValueSet(d.Get("policy_names").(*schema.Set))
+
+	if err := syncUserPoliciesExclusive(ctx, conn, userName, policyNames); err != nil {
+		return sdkdiag.AppendErrorf(diags, "synchronizing IAM User (%s) inline policies: %s", userName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(userName)
+	}
+
+	return append(diags, resourceUserPoliciesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceUserPoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	_, err := FindUserByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User Policies Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User Policies Exclusive (%s): %s", d.Id(), err)
+	}
+
+	policyNames, err := readUserPolicyNames(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User Policies Exclusive (%s) inline policies: %s", d.Id(), err)
+	}
+
+	d.Set("user_name", d.Id())
+	d.Set("policy_names", aws.StringValueSlice(policyNames))
+
+	return diags
+}
+
+// syncUserPoliciesExclusive deletes any inline policies embedded in the user
+// which are not in the configured set of policy names.
+func syncUserPoliciesExclusive(ctx context.Context, conn *iam.IAM, userName string, want []string) error {
+	have, err := readUserPolicyNames(ctx, conn, userName)
+
+	if err != nil {
+		return err
+	}
+
+	return deleteUserInlinePolicies(ctx, conn, userName, stringsNotIn(have, want))
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_iam_user_policy_attachments_exclusive", name="User Policy Attachments Exclusive")
+func ResourceUserPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserPolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceUserPolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceUserPolicyAttachmentsExclusivePut,
+		// Deleting this resource only stops exclusive management. The managed
+		// policies remain attached.
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserPolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	userName := d.Get("user_name").(string)
+	policyARNs := flex.ExpandStringValueSet(d.Get("policy_arns").(*schema.Set))
+
+	if err := syncUserPolicyAttachmentsExclusive(ctx, conn, userName, policyARNs); err != nil {
+		return sdkdiag.AppendErrorf(diags, "synchronizing IAM User (%s) policy attachments: %s", userName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(userName)
+	}
+
+	return append(diags, resourceUserPolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceUserPolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	_, err := FindUserByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User Policy Attachments Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User Policy Attachments Exclusive (%s): %s", d.Id(), err)
+	}
+
+	policyARNs, err := readUserPolicyAttachments(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User Policy Attachments Exclusive (%s) attached policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", aws.StringValueSlice(policyARNs))
+	d.Set("user_name", d.Id())
+
+	return diags
+}
+
+// syncUserPolicyAttachmentsExclusive detaches any managed policies attached to
+// the user which are not in the configured set of policy ARNs.
+func syncUserPolicyAttachmentsExclusive(ctx context.Context, conn *iam.IAM, userName string, want []string) error {
+	have, err := readUserPolicyAttachments(ctx, conn, userName)
+
+	if err != nil {
+		return err
+	}
+
+	return deleteUserPolicyAttachments(ctx, conn, userName, stringsNotIn(have, want))
+}
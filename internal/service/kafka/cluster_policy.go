@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kafka
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_msk_cluster_policy", name="Cluster Policy")
+func ResourceClusterPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClusterPolicyPut,
+		ReadWithoutTimeout:   resourceClusterPolicyRead,
+		UpdateWithoutTimeout: resourceClusterPolicyPut,
+		DeleteWithoutTimeout: resourceClusterPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"current_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceClusterPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn(ctx)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", d.Get("policy").(string), err)
+	}
+
+	clusterARN := d.Get("cluster_arn").(string)
+	input := &kafka.PutClusterPolicyInput{
+		ClusterArn: aws.String(clusterARN),
+		Policy:     aws.String(policy),
+	}
+
+	if !d.IsNewResource() {
+		input.CurrentVersion = aws.String(d.Get("current_version").(string))
+	}
+
+	_, err = conn.PutClusterPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("putting MSK Cluster Policy (%s): %s", clusterARN, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(clusterARN)
+	}
+
+	return resourceClusterPolicyRead(ctx, d, meta)
+}
+
+func resourceClusterPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn(ctx)
+
+	output, err := FindClusterPolicyByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MSK Cluster Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MSK Cluster Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cluster_arn", d.Id())
+	d.Set("current_version", output.CurrentVersion)
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(output.Policy))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("policy", policyToSet)
+
+	return nil
+}
+
+func resourceClusterPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn(ctx)
+
+	log.Printf("[DEBUG] Deleting MSK Cluster Policy: %s", d.Id())
+	_, err := conn.DeleteClusterPolicyWithContext(ctx, &kafka.DeleteClusterPolicyInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MSK Cluster Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -21,3 +21,17 @@ func PublicAccessType_Values() []string {
 		PublicAccessTypeServiceProvidedEIPs,
 	}
 }
+
+const (
+	VPCConnectionAuthenticationSASLIAM   = "SASL_IAM"
+	VPCConnectionAuthenticationSASLSCRAM = "SASL_SCRAM"
+	VPCConnectionAuthenticationTLS       = "TLS"
+)
+
+func VPCConnectionAuthentication_Values() []string {
+	return []string{
+		VPCConnectionAuthenticationSASLIAM,
+		VPCConnectionAuthenticationSASLSCRAM,
+		VPCConnectionAuthenticationTLS,
+	}
+}
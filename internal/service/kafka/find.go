@@ -144,3 +144,78 @@ func FindServerlessClusterByARN(ctx context.Context, conn *kafka.Kafka, arn stri
 
 	return output, nil
 }
+
+func FindClusterPolicyByARN(ctx context.Context, conn *kafka.Kafka, arn string) (*kafka.GetClusterPolicyOutput, error) {
+	input := &kafka.GetClusterPolicyInput{
+		ClusterArn: aws.String(arn),
+	}
+
+	output, err := conn.GetClusterPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindReplicatorByARN(ctx context.Context, conn *kafka.Kafka, arn string) (*kafka.DescribeReplicatorOutput, error) {
+	input := &kafka.DescribeReplicatorInput{
+		ReplicatorArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeReplicatorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindVPCConnectionByARN(ctx context.Context, conn *kafka.Kafka, arn string) (*kafka.DescribeVpcConnectionOutput, error) {
+	input := &kafka.DescribeVpcConnectionInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeVpcConnectionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -59,3 +59,35 @@ func statusConfigurationState(ctx context.Context, conn *kafka.Kafka, arn string
 		return output, aws.StringValue(output.State), nil
 	}
 }
+
+func statusReplicatorState(ctx context.Context, conn *kafka.Kafka, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReplicatorByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ReplicatorState), nil
+	}
+}
+
+func statusVPCConnectionState(ctx context.Context, conn *kafka.Kafka, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVPCConnectionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kafka
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_msk_vpc_connection", name="VPC Connection")
+// @Tags(identifierAttribute="id")
+func ResourceVPCConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCConnectionCreate,
+		ReadWithoutTimeout:   resourceVPCConnectionRead,
+		UpdateWithoutTimeout: resourceVPCConnectionUpdate,
+		DeleteWithoutTimeout: resourceVPCConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(VPCConnectionAuthentication_Values(), false),
+			},
+			"client_subnets": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_cluster_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVPCConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn(ctx)
+
+	clusterARN := d.Get("target_cluster_arn").(string)
+	input := &kafka.CreateVpcConnectionInput{
+		Authentication:   aws.String(d.Get("authentication").(string)),
+		ClientSubnets:    flex.ExpandStringSet(d.Get("client_subnets").(*schema.Set)),
+		SecurityGroups:   flex.ExpandStringSet(d.Get("security_groups").(*schema.Set)),
+		Tags:             getTagsIn(ctx),
+		TargetClusterArn: aws.String(clusterARN),
+		VpcId:            aws.String(d.Get("vpc_id").(string)),
+	}
+
+	output, err := conn.CreateVpcConnectionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating MSK VPC Connection (%s): %s", clusterARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.VpcConnectionArn))
+
+	if _, err := waitVPCConnectionCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for MSK VPC Connection (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceVPCConnectionRead(ctx, d, meta)
+}
+
+func resourceVPCConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn(ctx)
+
+	output, err := FindVPCConnectionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MSK VPC Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MSK VPC Connection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.VpcConnectionArn)
+	d.Set("authentication", output.Authentication)
+	d.Set("client_subnets", aws.StringValueSlice(output.Subnets))
+	d.Set("security_groups", aws.StringValueSlice(output.SecurityGroups))
+	d.Set("target_cluster_arn", output.TargetClusterArn)
+	d.Set("vpc_id", output.VpcId)
+
+	setTagsOut(ctx, output.Tags)
+
+	return nil
+}
+
+func resourceVPCConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceVPCConnectionRead(ctx, d, meta)
+}
+
+func resourceVPCConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn(ctx)
+
+	log.Printf("[DEBUG] Deleting MSK VPC Connection: %s", d.Id())
+	_, err := conn.DeleteVpcConnectionWithContext(ctx, &kafka.DeleteVpcConnectionInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MSK VPC Connection (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitVPCConnectionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for MSK VPC Connection (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,414 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	dataCellsFilterResourceIDPartCount = 4
+)
+
+// @SDKResource("aws_lakeformation_data_cells_filter")
+func ResourceDataCellsFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataCellsFilterCreate,
+		ReadWithoutTimeout:   resourceDataCellsFilterRead,
+		UpdateWithoutTimeout: resourceDataCellsFilterUpdate,
+		DeleteWithoutTimeout: resourceDataCellsFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"table_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							ExactlyOneOf: []string{
+								"table_data.0.column_names",
+								"table_data.0.column_wildcard",
+							},
+						},
+						"column_wildcard": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"excluded_column_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"table_data.0.column_names",
+								"table_data.0.column_wildcard",
+							},
+						},
+						"database_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"row_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"all_rows_wildcard": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ExactlyOneOf: []string{
+											"table_data.0.row_filter.0.all_rows_wildcard",
+											"table_data.0.row_filter.0.filter_expression",
+										},
+									},
+									"filter_expression": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ExactlyOneOf: []string{
+											"table_data.0.row_filter.0.all_rows_wildcard",
+											"table_data.0.row_filter.0.filter_expression",
+										},
+									},
+								},
+							},
+						},
+						"table_catalog_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"table_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataCellsFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	tableData := expandDataCellsFilter(d.Get("table_data").([]interface{})[0].(map[string]interface{}))
+	id, err := flex.FlattenResourceId([]string{
+		aws.StringValue(tableData.DatabaseName),
+		aws.StringValue(tableData.Name),
+		aws.StringValue(tableData.TableCatalogId),
+		aws.StringValue(tableData.TableName),
+	}, dataCellsFilterResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &lakeformation.CreateDataCellsFilterInput{
+		TableData: tableData,
+	}
+
+	_, err = conn.CreateDataCellsFilterWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Data Cells Filter (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	_, err = tfresource.RetryWhenNotFound(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return FindDataCellsFilterByID(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lake Formation Data Cells Filter (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDataCellsFilterRead(ctx, d, meta)...)
+}
+
+func resourceDataCellsFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	filter, err := FindDataCellsFilterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation Data Cells Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation Data Cells Filter (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("table_data", []interface{}{flattenDataCellsFilter(filter)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting table_data: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDataCellsFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	input := &lakeformation.UpdateDataCellsFilterInput{
+		TableData: expandDataCellsFilter(d.Get("table_data").([]interface{})[0].(map[string]interface{})),
+	}
+
+	_, err := conn.UpdateDataCellsFilterWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lake Formation Data Cells Filter (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDataCellsFilterRead(ctx, d, meta)...)
+}
+
+func resourceDataCellsFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), dataCellsFilterResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting Lake Formation Data Cells Filter: %s", d.Id())
+	_, err = conn.DeleteDataCellsFilterWithContext(ctx, &lakeformation.DeleteDataCellsFilterInput{
+		DatabaseName:   aws.String(parts[0]),
+		Name:           aws.String(parts[1]),
+		TableCatalogId: aws.String(parts[2]),
+		TableName:      aws.String(parts[3]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Data Cells Filter (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDataCellsFilterByID(ctx context.Context, conn *lakeformation.LakeFormation, id string) (*lakeformation.DataCellsFilter, error) {
+	parts, err := flex.ExpandResourceId(id, dataCellsFilterResourceIDPartCount, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &lakeformation.GetDataCellsFilterInput{
+		DatabaseName:   aws.String(parts[0]),
+		Name:           aws.String(parts[1]),
+		TableCatalogId: aws.String(parts[2]),
+		TableName:      aws.String(parts[3]),
+	}
+
+	output, err := conn.GetDataCellsFilterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DataCellsFilter == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DataCellsFilter, nil
+}
+
+func expandDataCellsFilter(tfMap map[string]interface{}) *lakeformation.DataCellsFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.DataCellsFilter{}
+
+	if v, ok := tfMap["column_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ColumnNames = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["column_wildcard"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ColumnWildcard = &lakeformation.ColumnWildcard{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["excluded_column_names"].(*schema.Set); ok && v.Len() > 0 {
+				apiObject.ColumnWildcard.ExcludedColumnNames = flex.ExpandStringSet(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["row_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RowFilter = expandRowFilter(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["table_catalog_id"].(string); ok && v != "" {
+		apiObject.TableCatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	if v, ok := tfMap["version_id"].(string); ok && v != "" {
+		apiObject.VersionId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRowFilter(tfMap map[string]interface{}) *lakeformation.RowFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.RowFilter{}
+
+	if v, ok := tfMap["all_rows_wildcard"].(bool); ok && v {
+		apiObject.AllRowsWildcard = &lakeformation.AllRowsWildcard{}
+	}
+
+	if v, ok := tfMap["filter_expression"].(string); ok && v != "" {
+		apiObject.FilterExpression = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataCellsFilter(apiObject *lakeformation.DataCellsFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ColumnNames; len(v) > 0 {
+		tfMap["column_names"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ColumnWildcard; v != nil {
+		tfMap["column_wildcard"] = []interface{}{map[string]interface{}{
+			"excluded_column_names": aws.StringValueSlice(v.ExcludedColumnNames),
+		}}
+	}
+
+	if v := apiObject.DatabaseName; v != nil {
+		tfMap["database_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RowFilter; v != nil {
+		tfMap["row_filter"] = []interface{}{flattenRowFilter(v)}
+	}
+
+	if v := apiObject.TableCatalogId; v != nil {
+		tfMap["table_catalog_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TableName; v != nil {
+		tfMap["table_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.VersionId; v != nil {
+		tfMap["version_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenRowFilter(apiObject *lakeformation.RowFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if apiObject.AllRowsWildcard != nil {
+		tfMap["all_rows_wildcard"] = true
+	}
+
+	if v := apiObject.FilterExpression; v != nil {
+		tfMap["filter_expression"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
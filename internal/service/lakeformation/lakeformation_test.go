@@ -13,6 +13,12 @@ func TestAccLakeFormation_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"DataCellsFilter": {
+			"basic":          testAccDataCellsFilter_basic,
+			"columnWildcard": testAccDataCellsFilter_columnWildcard,
+			"disappears":     testAccDataCellsFilter_disappears,
+			"rowFilter":      testAccDataCellsFilter_rowFilter,
+		},
 		"DataLakeSettings": {
 			"basic":            testAccDataLakeSettings_basic,
 			"dataSource":       testAccDataLakeSettingsDataSource_basic,
@@ -24,6 +30,7 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"database":            testAccPermissions_database,
 			"databaseIAMAllowed":  testAccPermissions_databaseIAMAllowed,
 			"databaseMultiple":    testAccPermissions_databaseMultiple,
+			"dataCellsFilter":     testAccPermissions_dataCellsFilter,
 			"dataLocation":        testAccPermissions_dataLocation,
 			"disappears":          testAccPermissions_disappears,
 			"lfTag":               testAccPermissions_lfTag,
@@ -64,6 +71,10 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"values":          testAccLFTag_Values,
 			"valuesOverFifty": testAccLFTag_Values_overFifty,
 		},
+		"LFTagExpression": {
+			"basic":      testAccLFTagExpression_basic,
+			"disappears": testAccLFTagExpression_disappears,
+		},
 		"ResourceLFTags": {
 			"basic":                testAccResourceLFTags_basic,
 			"database":             testAccResourceLFTags_database,
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	lfTagExpressionResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_lakeformation_lf_tag_expression")
+func ResourceLFTagExpression() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLFTagExpressionCreate,
+		ReadWithoutTimeout:   resourceLFTagExpressionRead,
+		UpdateWithoutTimeout: resourceLFTagExpressionUpdate,
+		DeleteWithoutTimeout: resourceLFTagExpressionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"expression": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateLFTagValues(),
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceLFTagExpressionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	catalogID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = v.(string)
+	}
+	name := d.Get("name").(string)
+	id, err := flex.FlattenResourceId([]string{catalogID, name}, lfTagExpressionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &lakeformation.CreateLFTagExpressionInput{
+		CatalogId:  aws.String(catalogID),
+		Expression: expandLFTagExpressionTags(d.Get("expression").(*schema.Set).List()),
+		Name:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err = conn.CreateLFTagExpression(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation LF-Tag Expression (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceLFTagExpressionRead(ctx, d, meta)...)
+}
+
+func resourceLFTagExpressionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), lfTagExpressionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	catalogID, name := parts[0], parts[1]
+	output, err := FindLFTagExpressionByTwoPartKey(ctx, conn, catalogID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation LF-Tag Expression (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation LF-Tag Expression (%s): %s", d.Id(), err)
+	}
+
+	d.Set("catalog_id", output.CatalogId)
+	d.Set("description", output.Description)
+	if err := d.Set("expression", flattenLFTagExpressionTags(output.Expression)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting expression: %s", err)
+	}
+	d.Set("name", output.Name)
+
+	return diags
+}
+
+func resourceLFTagExpressionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	input := &lakeformation.UpdateLFTagExpressionInput{
+		CatalogId:   aws.String(d.Get("catalog_id").(string)),
+		Description: aws.String(d.Get("description").(string)),
+		Expression:  expandLFTagExpressionTags(d.Get("expression").(*schema.Set).List()),
+		Name:        aws.String(d.Get("name").(string)),
+	}
+
+	_, err := conn.UpdateLFTagExpression(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lake Formation LF-Tag Expression (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceLFTagExpressionRead(ctx, d, meta)...)
+}
+
+func resourceLFTagExpressionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	log.Printf("[INFO] Deleting Lake Formation LF-Tag Expression: %s", d.Id())
+	_, err := conn.DeleteLFTagExpression(ctx, &lakeformation.DeleteLFTagExpressionInput{
+		CatalogId: aws.String(d.Get("catalog_id").(string)),
+		Name:      aws.String(d.Get("name").(string)),
+	})
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation LF-Tag Expression (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindLFTagExpressionByTwoPartKey(ctx context.Context, conn *lakeformation.Client, catalogID, name string) (*lakeformation.GetLFTagExpressionOutput, error) {
+	input := &lakeformation.GetLFTagExpressionInput{
+		CatalogId: aws.String(catalogID),
+		Name:      aws.String(name),
+	}
+
+	output, err := conn.GetLFTagExpression(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandLFTagExpressionTags(tfList []interface{}) []awstypes.LFTag {
+	var apiObjects []awstypes.LFTag
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.LFTag{
+			TagKey:    aws.String(tfMap["key"].(string)),
+			TagValues: flex.ExpandStringValueSet(tfMap["values"].(*schema.Set)),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLFTagExpressionTags(apiObjects []awstypes.LFTag) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"key":    aws.ToString(apiObject.TagKey),
+			"values": flex.FlattenStringValueSet(apiObject.TagValues),
+		})
+	}
+
+	return tfList
+}
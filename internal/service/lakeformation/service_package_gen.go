@@ -5,6 +5,8 @@ package lakeformation
 import (
 	"context"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	lakeformation_sdkv2 "github.com/aws/aws-sdk-go-v2/service/lakeformation"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	lakeformation_sdkv1 "github.com/aws/aws-sdk-go/service/lakeformation"
@@ -42,6 +44,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceDataCellsFilter,
+			TypeName: "aws_lakeformation_data_cells_filter",
+		},
 		{
 			Factory:  ResourceDataLakeSettings,
 			TypeName: "aws_lakeformation_data_lake_settings",
@@ -50,6 +56,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceLFTag,
 			TypeName: "aws_lakeformation_lf_tag",
 		},
+		{
+			Factory:  ResourceLFTagExpression,
+			TypeName: "aws_lakeformation_lf_tag_expression",
+		},
 		{
 			Factory:  ResourcePermissions,
 			TypeName: "aws_lakeformation_permissions",
@@ -76,6 +86,17 @@ func (p *servicePackage) NewConn(ctx context.Context, config map[string]any) (*l
 	return lakeformation_sdkv1.New(sess.Copy(&aws_sdkv1.Config{Endpoint: aws_sdkv1.String(config["endpoint"].(string))})), nil
 }
 
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*lakeformation_sdkv2.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
+
+	return lakeformation_sdkv2.NewFromConfig(cfg, func(o *lakeformation_sdkv2.Options) {
+		if endpoint := config["endpoint"].(string); endpoint != "" {
+			o.EndpointResolver = lakeformation_sdkv2.EndpointResolverFromURL(endpoint)
+		}
+	}), nil
+}
+
 func ServicePackage(ctx context.Context) conns.ServicePackage {
 	return &servicePackage{}
 }
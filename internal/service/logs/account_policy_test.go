@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLogsAccountPolicy_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		"dataProtection":     testAccAccountPolicy_dataProtection,
+		"disappears":         testAccAccountPolicy_disappears,
+		"subscriptionFilter": testAccAccountPolicy_subscriptionFilter,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccAccountPolicy_dataProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudwatchlogs.AccountPolicy
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_account_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPolicyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPolicyConfig_dataProtection(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "DATA_PROTECTION_POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "scope", "ALL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAccountPolicyImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAccountPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudwatchlogs.AccountPolicy
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_account_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPolicyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPolicyConfig_dataProtection(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, t, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflogs.ResourceAccountPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAccountPolicy_subscriptionFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudwatchlogs.AccountPolicy
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_account_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPolicyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPolicyConfig_subscriptionFilter(rName, fmt.Sprintf("LogGroupName NOT IN [%q]", rName)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "SUBSCRIPTION_FILTER_POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "selection_criteria", fmt.Sprintf("LogGroupName NOT IN [%q]", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAccountPolicyImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountPolicyConfig_subscriptionFilter(rName, fmt.Sprintf("LogGroupName NOT IN [%q, \"other\"]", rName)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "selection_criteria", fmt.Sprintf("LogGroupName NOT IN [%q, \"other\"]", rName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountPolicyImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.ID, rs.Primary.Attributes["policy_type"]), nil
+	}
+}
+
+func testAccCheckAccountPolicyDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(t).LogsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_account_policy" {
+				continue
+			}
+
+			_, err := tflogs.FindAccountPolicyByTwoPartKey(ctx, conn, rs.Primary.Attributes["policy_type"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Logs Account Policy still exists: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountPolicyExists(ctx context.Context, t *testing.T, n string, v *cloudwatchlogs.AccountPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Logs Account Policy ID is set")
+		}
+
+		conn := acctest.ProviderMeta(t).LogsConn(ctx)
+
+		output, err := tflogs.FindAccountPolicyByTwoPartKey(ctx, conn, rs.Primary.Attributes["policy_type"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAccountPolicyConfig_dataProtection(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_cloudwatch_log_account_policy" "test" {
+  policy_name = %[1]q
+  policy_type = "DATA_PROTECTION_POLICY"
+
+  policy_document = jsonencode({
+    Name    = "Test"
+    Version = "2021-06-01"
+
+    Statement = [
+      {
+        Sid            = "Audit"
+        DataIdentifier = ["arn:${data.aws_partition.current.partition}:dataprotection::aws:data-identifier/EmailAddress"]
+        Operation = {
+          Audit = {
+            FindingsDestination = {}
+          }
+        }
+      },
+      {
+        Sid            = "Redact"
+        DataIdentifier = ["arn:${data.aws_partition.current.partition}:dataprotection::aws:data-identifier/EmailAddress"]
+        Operation = {
+          Deidentify = {
+            MaskConfig = {}
+          }
+        }
+      }
+    ]
+  })
+}
+`, rName)
+}
+
+func testAccAccountPolicyConfig_subscriptionFilter(rName, selectionCriteria string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "logs.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "kinesis:PutRecord"
+      Resource = aws_kinesis_stream.test.arn
+    }]
+  })
+}
+
+resource "aws_cloudwatch_log_account_policy" "test" {
+  policy_name        = %[1]q
+  policy_type        = "SUBSCRIPTION_FILTER_POLICY"
+  selection_criteria = %[2]q
+
+  policy_document = jsonencode({
+    DestinationArn = aws_kinesis_stream.test.arn
+    RoleArn        = aws_iam_role.test.arn
+    FilterPattern  = "test"
+  })
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, selectionCriteria)
+}
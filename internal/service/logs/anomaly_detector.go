@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_log_anomaly_detector", name="Anomaly Detector")
+// @Tags(identifierAttribute="arn")
+func resourceAnomalyDetector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnomalyDetectorCreate,
+		ReadWithoutTimeout:   resourceAnomalyDetectorRead,
+		UpdateWithoutTimeout: resourceAnomalyDetectorUpdate,
+		DeleteWithoutTimeout: resourceAnomalyDetectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"anomaly_visibility_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(7, 90),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"detector_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"evaluation_frequency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(cloudwatchlogs.EvaluationFrequency_Values(), false),
+			},
+			"filter_pattern": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"log_group_arn_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAnomalyDetectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	input := &cloudwatchlogs.CreateLogAnomalyDetectorInput{
+		LogGroupArnList: flex.ExpandStringSet(d.Get("log_group_arn_list").(*schema.Set)),
+		Tags:            getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("anomaly_visibility_time"); ok {
+		input.AnomalyVisibilityTime = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("detector_name"); ok {
+		input.DetectorName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_frequency"); ok {
+		input.EvaluationFrequency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("filter_pattern"); ok {
+		input.FilterPattern = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateLogAnomalyDetectorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating CloudWatch Logs Anomaly Detector: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.AnomalyDetectorArn))
+
+	// Anomaly detectors are always created enabled.
+	if !d.Get("enabled").(bool) {
+		if err := updateAnomalyDetector(ctx, conn, d); err != nil {
+			return diag.Errorf("disabling CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAnomalyDetectorRead(ctx, d, meta)
+}
+
+func resourceAnomalyDetectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	output, err := FindAnomalyDetectorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Anomaly Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	d.Set("anomaly_visibility_time", output.AnomalyVisibilityTime)
+	d.Set("arn", d.Id())
+	d.Set("detector_name", output.DetectorName)
+	d.Set("enabled", aws.StringValue(output.AnomalyDetectorStatus) != cloudwatchlogs.AnomalyDetectorStatusPaused)
+	d.Set("evaluation_frequency", output.EvaluationFrequency)
+	d.Set("filter_pattern", output.FilterPattern)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("log_group_arn_list", aws.StringValueSlice(output.LogGroupArnList))
+
+	return nil
+}
+
+func resourceAnomalyDetectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		if err := updateAnomalyDetector(ctx, conn, d); err != nil {
+			return diag.Errorf("updating CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAnomalyDetectorRead(ctx, d, meta)
+}
+
+func resourceAnomalyDetectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	log.Printf("[INFO] Deleting CloudWatch Logs Anomaly Detector: %s", d.Id())
+	_, err := conn.DeleteLogAnomalyDetectorWithContext(ctx, &cloudwatchlogs.DeleteLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateAnomalyDetector(ctx context.Context, conn *cloudwatchlogs.CloudWatchLogs, d *schema.ResourceData) error {
+	input := &cloudwatchlogs.UpdateLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(d.Id()),
+		Enabled:            aws.Bool(d.Get("enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("anomaly_visibility_time"); ok {
+		input.AnomalyVisibilityTime = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("evaluation_frequency"); ok {
+		input.EvaluationFrequency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("filter_pattern"); ok {
+		input.FilterPattern = aws.String(v.(string))
+	}
+
+	_, err := conn.UpdateLogAnomalyDetectorWithContext(ctx, input)
+
+	return err
+}
+
+func FindAnomalyDetectorByARN(ctx context.Context, conn *cloudwatchlogs.CloudWatchLogs, arn string) (*cloudwatchlogs.GetLogAnomalyDetectorOutput, error) {
+	input := &cloudwatchlogs.GetLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(arn),
+	}
+
+	output, err := conn.GetLogAnomalyDetectorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.AnomalyDetectorStatus); status == cloudwatchlogs.AnomalyDetectorStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
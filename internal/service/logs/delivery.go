@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_log_delivery", name="Delivery")
+// @Tags(identifierAttribute="arn")
+func resourceDelivery() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeliveryCreate,
+		ReadWithoutTimeout:   resourceDeliveryRead,
+		UpdateWithoutTimeout: resourceDeliveryUpdate,
+		DeleteWithoutTimeout: resourceDeliveryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_destination_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"delivery_destination_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_source_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDeliveryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	sourceName := d.Get("delivery_source_name").(string)
+	input := &cloudwatchlogs.CreateDeliveryInput{
+		DeliveryDestinationArn: aws.String(d.Get("delivery_destination_arn").(string)),
+		DeliverySourceName:     aws.String(sourceName),
+		Tags:                   getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateDeliveryWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating CloudWatch Logs Delivery (%s): %s", sourceName, err)
+	}
+
+	d.SetId(aws.StringValue(output.Delivery.Id))
+
+	return resourceDeliveryRead(ctx, d, meta)
+}
+
+func resourceDeliveryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	delivery, err := FindDeliveryByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Delivery (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Logs Delivery (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", delivery.Arn)
+	d.Set("delivery_destination_arn", delivery.DeliveryDestinationArn)
+	d.Set("delivery_destination_type", delivery.DeliveryDestinationType)
+	d.Set("delivery_source_name", delivery.DeliverySourceName)
+
+	return nil
+}
+
+func resourceDeliveryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceDeliveryRead(ctx, d, meta)
+}
+
+func resourceDeliveryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	log.Printf("[INFO] Deleting CloudWatch Logs Delivery: %s", d.Id())
+	_, err := conn.DeleteDeliveryWithContext(ctx, &cloudwatchlogs.DeleteDeliveryInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CloudWatch Logs Delivery (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindDeliveryByID(ctx context.Context, conn *cloudwatchlogs.CloudWatchLogs, id string) (*cloudwatchlogs.Delivery, error) {
+	input := &cloudwatchlogs.GetDeliveryInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetDeliveryWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Delivery == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Delivery, nil
+}
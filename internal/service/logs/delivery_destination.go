@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_log_delivery_destination", name="Delivery Destination")
+// @Tags(identifierAttribute="arn")
+func resourceDeliveryDestination() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeliveryDestinationCreate,
+		ReadWithoutTimeout:   resourceDeliveryDestinationRead,
+		UpdateWithoutTimeout: resourceDeliveryDestinationUpdate,
+		DeleteWithoutTimeout: resourceDeliveryDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_destination_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_resource_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"delivery_destination_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"output_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cloudwatchlogs.OutputFormat_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDeliveryDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &cloudwatchlogs.PutDeliveryDestinationInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("delivery_destination_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeliveryDestinationConfiguration = expandDeliveryDestinationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("output_format"); ok {
+		input.OutputFormat = aws.String(v.(string))
+	}
+
+	output, err := conn.PutDeliveryDestinationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating CloudWatch Logs Delivery Destination (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DeliveryDestination.Name))
+
+	return resourceDeliveryDestinationRead(ctx, d, meta)
+}
+
+func resourceDeliveryDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	deliveryDestination, err := FindDeliveryDestinationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Delivery Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Logs Delivery Destination (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", deliveryDestination.Arn)
+	if deliveryDestination.DeliveryDestinationConfiguration != nil {
+		if err := d.Set("delivery_destination_configuration", []interface{}{flattenDeliveryDestinationConfiguration(deliveryDestination.DeliveryDestinationConfiguration)}); err != nil {
+			return diag.Errorf("setting delivery_destination_configuration: %s", err)
+		}
+	} else {
+		d.Set("delivery_destination_configuration", nil)
+	}
+	d.Set("delivery_destination_type", deliveryDestination.DeliveryDestinationType)
+	d.Set("name", deliveryDestination.Name)
+	d.Set("output_format", deliveryDestination.OutputFormat)
+
+	return nil
+}
+
+func resourceDeliveryDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &cloudwatchlogs.PutDeliveryDestinationInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("delivery_destination_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DeliveryDestinationConfiguration = expandDeliveryDestinationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("output_format"); ok {
+			input.OutputFormat = aws.String(v.(string))
+		}
+
+		_, err := conn.PutDeliveryDestinationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating CloudWatch Logs Delivery Destination (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDeliveryDestinationRead(ctx, d, meta)
+}
+
+func resourceDeliveryDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	log.Printf("[INFO] Deleting CloudWatch Logs Delivery Destination: %s", d.Id())
+	_, err := conn.DeleteDeliveryDestinationWithContext(ctx, &cloudwatchlogs.DeleteDeliveryDestinationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CloudWatch Logs Delivery Destination (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindDeliveryDestinationByName(ctx context.Context, conn *cloudwatchlogs.CloudWatchLogs, name string) (*cloudwatchlogs.DeliveryDestination, error) {
+	input := &cloudwatchlogs.GetDeliveryDestinationInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetDeliveryDestinationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DeliveryDestination == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DeliveryDestination, nil
+}
+
+func expandDeliveryDestinationConfiguration(tfMap map[string]interface{}) *cloudwatchlogs.DeliveryDestinationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudwatchlogs.DeliveryDestinationConfiguration{}
+
+	if v, ok := tfMap["destination_resource_arn"].(string); ok && v != "" {
+		apiObject.DestinationResourceArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDeliveryDestinationConfiguration(apiObject *cloudwatchlogs.DeliveryDestinationConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DestinationResourceArn; v != nil {
+		tfMap["destination_resource_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
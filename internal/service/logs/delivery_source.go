@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_log_delivery_source", name="Delivery Source")
+// @Tags(identifierAttribute="arn")
+func resourceDeliverySource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeliverySourceCreate,
+		ReadWithoutTimeout:   resourceDeliverySourceRead,
+		UpdateWithoutTimeout: resourceDeliverySourceUpdate,
+		DeleteWithoutTimeout: resourceDeliverySourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDeliverySourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &cloudwatchlogs.PutDeliverySourceInput{
+		LogType:     aws.String(d.Get("log_type").(string)),
+		Name:        aws.String(name),
+		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+		Tags:        getTagsIn(ctx),
+	}
+
+	output, err := conn.PutDeliverySourceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating CloudWatch Logs Delivery Source (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DeliverySource.Name))
+
+	return resourceDeliverySourceRead(ctx, d, meta)
+}
+
+func resourceDeliverySourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	deliverySource, err := FindDeliverySourceByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Delivery Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Logs Delivery Source (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", deliverySource.Arn)
+	d.Set("log_type", deliverySource.LogType)
+	d.Set("name", deliverySource.Name)
+	if len(deliverySource.ResourceArns) > 0 {
+		d.Set("resource_arn", deliverySource.ResourceArns[0])
+	} else {
+		d.Set("resource_arn", nil)
+	}
+	d.Set("service", deliverySource.Service)
+
+	return nil
+}
+
+func resourceDeliverySourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &cloudwatchlogs.PutDeliverySourceInput{
+			LogType:     aws.String(d.Get("log_type").(string)),
+			Name:        aws.String(d.Id()),
+			ResourceArn: aws.String(d.Get("resource_arn").(string)),
+		}
+
+		_, err := conn.PutDeliverySourceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating CloudWatch Logs Delivery Source (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDeliverySourceRead(ctx, d, meta)
+}
+
+func resourceDeliverySourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn(ctx)
+
+	log.Printf("[INFO] Deleting CloudWatch Logs Delivery Source: %s", d.Id())
+	_, err := conn.DeleteDeliverySourceWithContext(ctx, &cloudwatchlogs.DeleteDeliverySourceInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CloudWatch Logs Delivery Source (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindDeliverySourceByName(ctx context.Context, conn *cloudwatchlogs.CloudWatchLogs, name string) (*cloudwatchlogs.DeliverySource, error) {
+	input := &cloudwatchlogs.GetDeliverySourceInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetDeliverySourceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DeliverySource == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DeliverySource, nil
+}
@@ -43,6 +43,13 @@ func resourceGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"log_group_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cloudwatchlogs.LogGroupClass_Values(), false),
+			},
 			"name": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -91,6 +98,10 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.KmsKeyId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("log_group_class"); ok {
+		input.LogGroupClass = aws.String(v.(string))
+	}
+
 	_, err := conn.CreateLogGroupWithContext(ctx, input)
 
 	if err != nil {
@@ -134,6 +145,7 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	d.Set("arn", TrimLogGroupARNWildcardSuffix(aws.StringValue(lg.Arn)))
 	d.Set("kms_key_id", lg.KmsKeyId)
+	d.Set("log_group_class", lg.LogGroupClass)
 	d.Set("name", lg.LogGroupName)
 	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(lg.LogGroupName)))
 	d.Set("retention_in_days", lg.RetentionInDays)
@@ -35,6 +35,7 @@ func TestAccLogsGroup_basic(t *testing.T) {
 					testAccCheckGroupExists(ctx, t, resourceName, &v),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "logs", fmt.Sprintf("log-group:%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, "kms_key_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "log_group_class", "STANDARD"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "name_prefix", ""),
 					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "0"),
@@ -226,6 +227,35 @@ func TestAccLogsGroup_kmsKey(t *testing.T) {
 	})
 }
 
+func TestAccLogsGroup_logGroupClass(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudwatchlogs.LogGroup
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_group.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_logGroupClass(rName, "INFREQUENT_ACCESS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "log_group_class", "INFREQUENT_ACCESS"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retention_in_days", "skip_destroy"},
+			},
+		},
+	})
+}
+
 func TestAccLogsGroup_retentionPolicy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v cloudwatchlogs.LogGroup
@@ -434,6 +464,15 @@ resource "aws_cloudwatch_log_group" "test" {
 `, rName, tag1Key, tag1Value, tag2Key, tag2Value)
 }
 
+func testAccGroupConfig_logGroupClass(rName, logGroupClass string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name            = %[1]q
+  log_group_class = %[2]q
+}
+`, rName, logGroupClass)
+}
+
 func testAccGroupConfig_kmsKey(rName string, idx int) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
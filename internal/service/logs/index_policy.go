@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_cloudwatch_log_index_policy")
+func resourceIndexPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIndexPolicyPut,
+		ReadWithoutTimeout:   resourceIndexPolicyRead,
+		UpdateWithoutTimeout: resourceIndexPolicyPut,
+		DeleteWithoutTimeout: resourceIndexPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validLogGroupName,
+			},
+			"policy_document": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceIndexPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	logGroupName := d.Get("log_group_name").(string)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy_document").(string))
+
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", policy, err)
+	}
+
+	input := &cloudwatchlogs.PutIndexPolicyInput{
+		LogGroupIdentifier: aws.String(logGroupName),
+		PolicyDocument:     aws.String(policy),
+	}
+
+	_, err = conn.PutIndexPolicy(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("putting CloudWatch Logs Index Policy (%s): %s", logGroupName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(logGroupName)
+	}
+
+	return resourceIndexPolicyRead(ctx, d, meta)
+}
+
+func resourceIndexPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	output, err := FindIndexPolicyByLogGroupName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Index Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Logs Index Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("log_group_name", output.LogGroupIdentifier)
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy_document").(string), aws.ToString(output.PolicyDocument))
+
+	if err != nil {
+		return diag.Errorf("while setting policy (%s), encountered: %s", policyToSet, err)
+	}
+
+	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", policyToSet, err)
+	}
+
+	d.Set("policy_document", policyToSet)
+
+	return nil
+}
+
+func resourceIndexPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting CloudWatch Logs Index Policy: %s", d.Id())
+	_, err := conn.DeleteIndexPolicy(ctx, &cloudwatchlogs.DeleteIndexPolicyInput{
+		LogGroupIdentifier: aws.String(d.Id()),
+	})
+
+	if nfe := (*types.ResourceNotFoundException)(nil); errors.As(err, &nfe) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CloudWatch Logs Index Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindIndexPolicyByLogGroupName(ctx context.Context, conn *cloudwatchlogs.Client, logGroupName string) (*types.IndexPolicy, error) {
+	input := &cloudwatchlogs.DescribeIndexPoliciesInput{
+		LogGroupIdentifiers: []string{logGroupName},
+	}
+
+	output, err := conn.DescribeIndexPolicies(ctx, input)
+
+	if nfe := (*types.ResourceNotFoundException)(nil); errors.As(err, &nfe) {
+		return nil, &retry.NotFoundError{
+			LastError:   nfe,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.IndexPolicies) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.IndexPolicies); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output.IndexPolicies[0], nil
+}
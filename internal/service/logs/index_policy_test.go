@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsIndexPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.IndexPolicy
+	resourceName := "aws_cloudwatch_log_index_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchLogsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexPolicyConfig_basic(rName, `["eventName"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_name", "aws_cloudwatch_log_group.test", "name"),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "policy_document", `{"Fields":["eventName"]}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIndexPolicyConfig_basic(rName, `["eventName", "requestId"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexPolicyExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "policy_document", `{"Fields":["eventName","requestId"]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsIndexPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.IndexPolicy
+	resourceName := "aws_cloudwatch_log_index_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchLogsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexPolicyConfig_basic(rName, `["eventName"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexPolicyExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflogs.ResourceIndexPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIndexPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_index_policy" {
+				continue
+			}
+
+			_, err := tflogs.FindIndexPolicyByLogGroupName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Logs Index Policy still exists: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIndexPolicyExists(ctx context.Context, n string, v *types.IndexPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Logs Index Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		output, err := tflogs.FindIndexPolicyByLogGroupName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIndexPolicyConfig_basic(rName, fields string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_index_policy" "test" {
+  log_group_name = aws_cloudwatch_log_group.test.name
+  policy_document = jsonencode({
+    Fields = %[2]s
+  })
+}
+`, rName, fields)
+}
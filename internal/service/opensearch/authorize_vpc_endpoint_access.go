@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	authorizeVPCEndpointAccessResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_opensearch_authorize_vpc_endpoint_access", name="Authorize VPC Endpoint Access")
+func ResourceAuthorizeVPCEndpointAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAuthorizeVPCEndpointAccessCreate,
+		ReadWithoutTimeout:   resourceAuthorizeVPCEndpointAccessRead,
+		DeleteWithoutTimeout: resourceAuthorizeVPCEndpointAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"authorized_principal": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAuthorizeVPCEndpointAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	domainName := d.Get("domain_name").(string)
+	account := d.Get("account").(string)
+	id, err := flex.FlattenResourceId([]string{domainName, account}, authorizeVPCEndpointAccessResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &opensearchservice.AuthorizeVpcEndpointAccessInput{
+		Account:    aws.String(account),
+		DomainName: aws.String(domainName),
+	}
+
+	_, err = conn.AuthorizeVpcEndpointAccessWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Authorize VPC Endpoint Access (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceAuthorizeVPCEndpointAccessRead(ctx, d, meta)...)
+}
+
+func resourceAuthorizeVPCEndpointAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), authorizeVPCEndpointAccessResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainName, account := parts[0], parts[1]
+	principal, err := FindAuthorizedPrincipalByTwoPartKey(ctx, conn, domainName, account)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Authorize VPC Endpoint Access (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Authorize VPC Endpoint Access (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account", account)
+	if err := d.Set("authorized_principal", []interface{}{flattenAuthorizedPrincipal(principal)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting authorized_principal: %s", err)
+	}
+	d.Set("domain_name", domainName)
+
+	return diags
+}
+
+func resourceAuthorizeVPCEndpointAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), authorizeVPCEndpointAccessResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting OpenSearch Authorize VPC Endpoint Access: %s", d.Id())
+	_, err = conn.RevokeVpcEndpointAccessWithContext(ctx, &opensearchservice.RevokeVpcEndpointAccessInput{
+		Account:    aws.String(parts[1]),
+		DomainName: aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Authorize VPC Endpoint Access (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func flattenAuthorizedPrincipal(apiObject *opensearchservice.AuthorizedPrincipal) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Principal; v != nil {
+		tfMap["principal"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PrincipalType; v != nil {
+		tfMap["principal_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
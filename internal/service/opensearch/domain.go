@@ -555,6 +555,21 @@ func ResourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"software_update_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_software_update_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"vpc_options": {
@@ -734,6 +749,10 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	if v, ok := d.GetOk("software_update_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SoftwareUpdateOptions = expandSoftwareUpdateOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	// IAM Roles can take some time to propagate if set in AccessPolicies and created in the same terraform
 	var out *opensearchservice.CreateDomainOutput
 	err = retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
@@ -934,6 +953,14 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 		d.Set("off_peak_window_options", nil)
 	}
 
+	if ds.SoftwareUpdateOptions != nil {
+		if err := d.Set("software_update_options", []interface{}{flattenSoftwareUpdateOptions(ds.SoftwareUpdateOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting software_update_options: %s", err)
+		}
+	} else {
+		d.Set("software_update_options", nil)
+	}
+
 	return diags
 }
 
@@ -1053,6 +1080,12 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			}
 		}
 
+		if d.HasChange("software_update_options") {
+			if v, ok := d.GetOk("software_update_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SoftwareUpdateOptions = expandSoftwareUpdateOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChange("vpc_options") {
 			options := d.Get("vpc_options").([]interface{})
 			s := options[0].(map[string]interface{})
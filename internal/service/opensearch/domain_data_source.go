@@ -358,6 +358,18 @@ func DataSourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"software_update_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_software_update_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tags": tftags.TagsSchemaComputed(),
 			"vpc_options": {
 				Type:     schema.TypeList,
@@ -508,6 +520,14 @@ func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("off_peak_window_options", nil)
 	}
 
+	if ds.SoftwareUpdateOptions != nil {
+		if err := d.Set("software_update_options", []interface{}{flattenSoftwareUpdateOptions(ds.SoftwareUpdateOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting software_update_options: %s", err)
+		}
+	} else {
+		d.Set("software_update_options", nil)
+	}
+
 	d.Set("created", ds.Created)
 	d.Set("deleted", ds.Deleted)
 	d.Set("processing", ds.Processing)
@@ -205,6 +205,20 @@ func expandOffPeakWindow(tfMap map[string]interface{}) *opensearchservice.OffPea
 	return apiObject
 }
 
+func expandSoftwareUpdateOptions(tfMap map[string]interface{}) *opensearchservice.SoftwareUpdateOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &opensearchservice.SoftwareUpdateOptions{}
+
+	if v, ok := tfMap["auto_software_update_enabled"].(bool); ok {
+		apiObject.AutoSoftwareUpdateEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
 func expandWindowStartTime(tfMap map[string]interface{}) *opensearchservice.WindowStartTime {
 	if tfMap == nil {
 		return nil
@@ -421,3 +435,17 @@ func flattenWindowStartTime(apiObject *opensearchservice.WindowStartTime) map[st
 
 	return tfMap
 }
+
+func flattenSoftwareUpdateOptions(apiObject *opensearchservice.SoftwareUpdateOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AutoSoftwareUpdateEnabled; v != nil {
+		tfMap["auto_software_update_enabled"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
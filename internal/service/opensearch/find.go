@@ -5,6 +5,7 @@ package opensearch
 
 import (
 	"context"
+	"errors"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
@@ -36,3 +37,146 @@ func FindDomainByName(ctx context.Context, conn *opensearchservice.OpenSearchSer
 
 	return output.DomainStatus, nil
 }
+
+func FindVPCEndpointByID(ctx context.Context, conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.VpcEndpoint, error) {
+	input := &opensearchservice.DescribeVpcEndpointsInput{
+		VpcEndpointIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeVpcEndpointsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if errs := output.VpcEndpointErrors; len(errs) > 0 {
+		if v := errs[0]; aws.StringValue(v.ErrorCode) == opensearchservice.VpcEndpointErrorCodeEndpointNotFound {
+			return nil, &retry.NotFoundError{
+				Message:     aws.StringValue(v.ErrorMessage),
+				LastRequest: input,
+			}
+		}
+
+		return nil, errors.New(aws.StringValue(errs[0].ErrorMessage))
+	}
+
+	return tfresource.AssertSinglePtrResult(output.VpcEndpoints)
+}
+
+func FindAuthorizedPrincipalByTwoPartKey(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, account string) (*opensearchservice.AuthorizedPrincipal, error) {
+	input := &opensearchservice.ListVpcEndpointAccessInput{
+		DomainName: aws.String(domainName),
+	}
+
+	for {
+		output, err := conn.ListVpcEndpointAccessWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, v := range output.AuthorizedPrincipalList {
+			if v != nil && aws.StringValue(v.Principal) == account {
+				return v, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func FindPackageByID(ctx context.Context, conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.PackageDetails, error) {
+	input := &opensearchservice.DescribePackagesInput{
+		Filters: []*opensearchservice.DescribePackagesFilter{
+			{
+				Name:  aws.String(opensearchservice.DescribePackagesFilterNamePackageId),
+				Value: aws.StringSlice([]string{id}),
+			},
+		},
+	}
+	var output []*opensearchservice.PackageDetails
+
+	err := conn.DescribePackagesPagesWithContext(ctx, input, func(page *opensearchservice.DescribePackagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PackageDetailsList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func FindPackageAssociationByTwoPartKey(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string) (*opensearchservice.DomainPackageDetails, error) {
+	input := &opensearchservice.ListPackagesForDomainInput{
+		DomainName: aws.String(domainName),
+	}
+	var output []*opensearchservice.DomainPackageDetails
+
+	err := conn.ListPackagesForDomainPagesWithContext(ctx, input, func(page *opensearchservice.ListPackagesForDomainOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DomainPackageDetailsList {
+			if v != nil && aws.StringValue(v.PackageID) == packageID {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
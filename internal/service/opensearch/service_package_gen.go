@@ -34,6 +34,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceAuthorizeVPCEndpointAccess,
+			TypeName: "aws_opensearch_authorize_vpc_endpoint_access",
+			Name:     "Authorize VPC Endpoint Access",
+		},
 		{
 			Factory:  ResourceDomain,
 			TypeName: "aws_opensearch_domain",
@@ -58,6 +63,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceOutboundConnection,
 			TypeName: "aws_opensearch_outbound_connection",
 		},
+		{
+			Factory:  ResourcePackage,
+			TypeName: "aws_opensearch_package",
+			Name:     "Package",
+		},
+		{
+			Factory:  ResourcePackageAssociation,
+			TypeName: "aws_opensearch_package_association",
+			Name:     "Package Association",
+		},
+		{
+			Factory:  ResourceVPCEndpoint,
+			TypeName: "aws_opensearch_vpc_endpoint",
+			Name:     "VPC Endpoint",
+		},
 	}
 }
 
@@ -156,3 +156,96 @@ func waitForDomainDelete(ctx context.Context, conn *opensearchservice.OpenSearch
 
 	return err
 }
+
+func waitVPCEndpointCreated(ctx context.Context, conn *opensearchservice.OpenSearchService, id string, timeout time.Duration) (*opensearchservice.VpcEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{opensearchservice.VpcEndpointStatusCreating},
+		Target:  []string{opensearchservice.VpcEndpointStatusActive},
+		Refresh: statusVPCEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.VpcEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCEndpointUpdated(ctx context.Context, conn *opensearchservice.OpenSearchService, id string, timeout time.Duration) (*opensearchservice.VpcEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{opensearchservice.VpcEndpointStatusUpdating},
+		Target:  []string{opensearchservice.VpcEndpointStatusActive},
+		Refresh: statusVPCEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.VpcEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCEndpointDeleted(ctx context.Context, conn *opensearchservice.OpenSearchService, id string, timeout time.Duration) (*opensearchservice.VpcEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{opensearchservice.VpcEndpointStatusDeleting},
+		Target:  []string{},
+		Refresh: statusVPCEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.VpcEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageAssociationCreated(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string, timeout time.Duration) (*opensearchservice.DomainPackageDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{opensearchservice.DomainPackageStatusAssociating},
+		Target:  []string{opensearchservice.DomainPackageStatusActive},
+		Refresh: statusPackageAssociation(ctx, conn, domainName, packageID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.DomainPackageDetails); ok {
+		if v := output.ErrorDetails; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.ErrorType), aws.StringValue(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageAssociationDeleted(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string, timeout time.Duration) (*opensearchservice.DomainPackageDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{opensearchservice.DomainPackageStatusDissociating},
+		Target:  []string{},
+		Refresh: statusPackageAssociation(ctx, conn, domainName, packageID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.DomainPackageDetails); ok {
+		if v := output.ErrorDetails; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.ErrorType), aws.StringValue(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
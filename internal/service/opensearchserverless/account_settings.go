@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Account Settings")
+func newResourceAccountSettings(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAccountSettings{}, nil
+}
+
+type resourceAccountSettingsData struct {
+	ID                       types.String `tfsdk:"id"`
+	MaxIndexingCapacityInOCU types.Int64  `tfsdk:"max_indexing_capacity_in_ocu"`
+	MaxSearchCapacityInOCU   types.Int64  `tfsdk:"max_search_capacity_in_ocu"`
+}
+
+const (
+	ResNameAccountSettings = "Account Settings"
+)
+
+type resourceAccountSettings struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceAccountSettings) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_opensearchserverless_account_settings"
+}
+
+func (r *resourceAccountSettings) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"max_indexing_capacity_in_ocu": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(2),
+				},
+			},
+			"max_search_capacity_in_ocu": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(2),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceAccountSettings) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceAccountSettingsData
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	out, err := conn.UpdateAccountSettings(ctx, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameAccountSettings, r.Meta().Region, nil),
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = types.StringValue(r.Meta().Region)
+	state.refreshFromOutput(out.AccountSettingsDetail)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceAccountSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	var state resourceAccountSettingsData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findAccountSettings(ctx, conn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameAccountSettings, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.refreshFromOutput(out)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceAccountSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	var plan, state resourceAccountSettingsData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.MaxIndexingCapacityInOCU.Equal(state.MaxIndexingCapacityInOCU) ||
+		!plan.MaxSearchCapacityInOCU.Equal(state.MaxSearchCapacityInOCU) {
+		out, err := conn.UpdateAccountSettings(ctx, plan.expand())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionUpdating, ResNameAccountSettings, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		plan.refreshFromOutput(out.AccountSettingsDetail)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the account settings from state only.
+// Account settings always exist and cannot be reset to their defaults.
+func (r *resourceAccountSettings) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *resourceAccountSettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (rd *resourceAccountSettingsData) expand() *opensearchserverless.UpdateAccountSettingsInput {
+	capacityLimits := &awstypes.CapacityLimits{}
+
+	if !rd.MaxIndexingCapacityInOCU.IsNull() && !rd.MaxIndexingCapacityInOCU.IsUnknown() {
+		capacityLimits.MaxIndexingCapacityInOCU = aws.Int32(int32(rd.MaxIndexingCapacityInOCU.ValueInt64()))
+	}
+
+	if !rd.MaxSearchCapacityInOCU.IsNull() && !rd.MaxSearchCapacityInOCU.IsUnknown() {
+		capacityLimits.MaxSearchCapacityInOCU = aws.Int32(int32(rd.MaxSearchCapacityInOCU.ValueInt64()))
+	}
+
+	return &opensearchserverless.UpdateAccountSettingsInput{
+		CapacityLimits: capacityLimits,
+	}
+}
+
+// refreshFromOutput writes state data from an AWS response object
+func (rd *resourceAccountSettingsData) refreshFromOutput(out *awstypes.AccountSettingsDetail) {
+	if out == nil || out.CapacityLimits == nil {
+		return
+	}
+
+	rd.MaxIndexingCapacityInOCU = types.Int64Value(int64(aws.ToInt32(out.CapacityLimits.MaxIndexingCapacityInOCU)))
+	rd.MaxSearchCapacityInOCU = types.Int64Value(int64(aws.ToInt32(out.CapacityLimits.MaxSearchCapacityInOCU)))
+}
+
+func findAccountSettings(ctx context.Context, conn *opensearchserverless.Client) (*awstypes.AccountSettingsDetail, error) {
+	in := &opensearchserverless.GetAccountSettingsInput{}
+	out, err := conn.GetAccountSettings(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AccountSettingsDetail == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.AccountSettingsDetail, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfopensearchserverless "github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchServerlessAccountSettings_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		"basic": testAccAccountSettings_basic,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccAccountSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_opensearchserverless_account_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsConfig_basic(4, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_indexing_capacity_in_ocu", "4"),
+					resource.TestCheckResourceAttr(resourceName, "max_search_capacity_in_ocu", "4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountSettingsConfig_basic(6, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_indexing_capacity_in_ocu", "6"),
+					resource.TestCheckResourceAttr(resourceName, "max_search_capacity_in_ocu", "8"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountSettingsExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.OpenSearchServerless, create.ErrActionCheckingExistence, tfopensearchserverless.ResNameAccountSettings, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessClient(ctx)
+		_, err := tfopensearchserverless.FindAccountSettings(ctx, conn)
+
+		if err != nil {
+			return create.Error(names.OpenSearchServerless, create.ErrActionCheckingExistence, tfopensearchserverless.ResNameAccountSettings, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountSettingsConfig_basic(indexing, search int) string {
+	return fmt.Sprintf(`
+resource "aws_opensearchserverless_account_settings" "test" {
+  max_indexing_capacity_in_ocu = %[1]d
+  max_search_capacity_in_ocu   = %[2]d
+}
+`, indexing, search)
+}
@@ -6,6 +6,7 @@ package opensearchserverless
 import (
 	"context"
 	"errors"
+	"fmt"
 	"regexp"
 	"time"
 
@@ -51,6 +52,7 @@ type resourceCollectionData struct {
 	ID                 types.String   `tfsdk:"id"`
 	KmsKeyARN          types.String   `tfsdk:"kms_key_arn"`
 	Name               types.String   `tfsdk:"name"`
+	StandbyReplicas    types.String   `tfsdk:"standby_replicas"`
 	Tags               types.Map      `tfsdk:"tags"`
 	TagsAll            types.Map      `tfsdk:"tags_all"`
 	Timeouts           timeouts.Value `tfsdk:"timeouts"`
@@ -110,6 +112,17 @@ func (r *resourceCollection) Schema(ctx context.Context, req resource.SchemaRequ
 						`must start with any lower case letter and can can include any lower case letter, number, or "-"`),
 				},
 			},
+			"standby_replicas": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.StandbyReplicas](),
+				},
+			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 			"type": schema.StringAttribute{
@@ -154,6 +167,10 @@ func (r *resourceCollection) Create(ctx context.Context, req resource.CreateRequ
 		in.Description = aws.String(plan.Description.ValueString())
 	}
 
+	if !plan.StandbyReplicas.IsNull() && !plan.StandbyReplicas.IsUnknown() {
+		in.StandbyReplicas = awstypes.StandbyReplicas(plan.StandbyReplicas.ValueString())
+	}
+
 	if !plan.Type.IsNull() {
 		in.Type = awstypes.CollectionType(plan.Type.ValueString())
 	}
@@ -187,6 +204,7 @@ func (r *resourceCollection) Create(ctx context.Context, req resource.CreateRequ
 	state.Description = flex.StringToFramework(ctx, waitOut.Description)
 	state.KmsKeyARN = flex.StringToFramework(ctx, waitOut.KmsKeyArn)
 	state.Name = flex.StringToFramework(ctx, waitOut.Name)
+	state.StandbyReplicas = flex.StringValueToFramework(ctx, waitOut.StandbyReplicas)
 	state.Type = flex.StringValueToFramework(ctx, waitOut.Type)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -215,6 +233,7 @@ func (r *resourceCollection) Read(ctx context.Context, req resource.ReadRequest,
 	state.ID = flex.StringToFramework(ctx, out.Id)
 	state.KmsKeyARN = flex.StringToFramework(ctx, out.KmsKeyArn)
 	state.Name = flex.StringToFramework(ctx, out.Name)
+	state.StandbyReplicas = flex.StringValueToFramework(ctx, out.StandbyReplicas)
 	state.Type = flex.StringValueToFramework(ctx, out.Type)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -315,6 +334,10 @@ func waitCollectionCreated(ctx context.Context, conn *opensearchserverless.Clien
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*awstypes.CollectionDetail); ok {
+		if output.Status == awstypes.CollectionStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(output.FailureCode), aws.ToString(output.FailureMessage)))
+		}
+
 		return output, err
 	}
 
@@ -46,6 +46,7 @@ func TestAccOpenSearchServerlessCollection_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "collection_endpoint"),
 					resource.TestCheckResourceAttrSet(resourceName, "dashboard_endpoint"),
 					resource.TestCheckResourceAttrSet(resourceName, "kms_key_arn"),
+					resource.TestCheckResourceAttr(resourceName, "standby_replicas", "ENABLED"),
 				),
 			},
 			{
@@ -76,10 +76,8 @@ func (r *resourceSecurityPolicy) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"policy": schema.StringAttribute{
-				Required: true,
-				Validators: []validator.String{
-					stringvalidator.LengthBetween(1, 20480),
-				},
+				Required:   true,
+				Validators: policyDocumentValidators(),
 			},
 			"policy_version": schema.StringAttribute{
 				Computed: true,
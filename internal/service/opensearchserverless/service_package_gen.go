@@ -36,6 +36,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newResourceAccessPolicy,
 		},
+		{
+			Factory: newResourceAccountSettings,
+			Name:    "Account Settings",
+		},
 		{
 			Factory: newResourceCollection,
 			Name:    "Collection",
@@ -43,6 +47,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory: newResourceLifecyclePolicy,
+		},
 		{
 			Factory: newResourceSecurityConfig,
 		},
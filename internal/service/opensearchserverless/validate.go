@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// policyDocumentValidator validates that a string Attribute's value is a valid JSON policy document.
+type policyDocumentValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator policyDocumentValidator) Description(_ context.Context) string {
+	return "value must be a valid JSON policy document"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator policyDocumentValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator policyDocumentValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(request.ConfigValue.ValueString())) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+		return
+	}
+}
+
+// policyDocumentValidators returns the validators shared by all OpenSearch Serverless policy documents.
+func policyDocumentValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, 20480),
+		policyDocumentValidator{},
+	}
+}
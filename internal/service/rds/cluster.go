@@ -120,6 +120,13 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"cluster_scalability_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ClusterScalabilityType_Values(), false),
+			},
 			"copy_tags_to_snapshot": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -949,6 +956,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			input.EnableHttpEndpoint = aws.Bool(v.(bool))
 		}
 
+		if v, ok := d.GetOk("cluster_scalability_type"); ok && v.(string) == ClusterScalabilityTypeLimitless {
+			input.EnableLimitlessDatabase = aws.Bool(true)
+		}
+
 		if v, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && v.(*schema.Set).Len() > 0 {
 			input.EnableCloudwatchLogsExports = flex.ExpandStringSet(v.(*schema.Set))
 		}
@@ -1105,6 +1116,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	d.Set("cluster_members", clusterMembers)
 	d.Set("cluster_resource_id", dbc.DbClusterResourceId)
+	d.Set("cluster_scalability_type", flattenClusterScalabilityType(dbc.LimitlessDatabase, d.Get("cluster_scalability_type").(string)))
 	d.Set("copy_tags_to_snapshot", dbc.CopyTagsToSnapshot)
 	// Only set the DatabaseName if it is not nil. There is a known API bug where
 	// RDS accepts a DatabaseName but does not return it, causing a perpetual
@@ -229,6 +229,25 @@ func TimeoutAction_Values() []string {
 	}
 }
 
+const (
+	ClusterScalabilityTypeLimitless = "limitless"
+	ClusterScalabilityTypeStandard  = "standard"
+)
+
+func ClusterScalabilityType_Values() []string {
+	return []string{
+		ClusterScalabilityTypeLimitless,
+		ClusterScalabilityTypeStandard,
+	}
+}
+
+const (
+	DBShardGroupStatusAvailable = "available"
+	DBShardGroupStatusCreating  = "creating"
+	DBShardGroupStatusDeleting  = "deleting"
+	DBShardGroupStatusModifying = "modifying"
+)
+
 const (
 	backupTargetOutposts = "outposts"
 	backupTargetRegion   = "region"
@@ -385,3 +385,111 @@ func FindReservedDBInstanceByID(ctx context.Context, conn *rds.RDS, id string) (
 
 	return output.ReservedDBInstances[0], nil
 }
+
+func FindIntegrationByARN(ctx context.Context, conn *rds.RDS, arn string) (*rds.Integration, error) {
+	input := &rds.DescribeIntegrationsInput{
+		IntegrationIdentifier: aws.String(arn),
+	}
+
+	output, err := findIntegration(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := aws.StringValue(output.Status); status == rds.IntegrationStatusDeleting {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findIntegration(ctx context.Context, conn *rds.RDS, input *rds.DescribeIntegrationsInput) (*rds.Integration, error) {
+	output, err := findIntegrations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func findIntegrations(ctx context.Context, conn *rds.RDS, input *rds.DescribeIntegrationsInput) ([]*rds.Integration, error) {
+	var output []*rds.Integration
+
+	err := conn.DescribeIntegrationsPagesWithContext(ctx, input, func(page *rds.DescribeIntegrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Integrations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeIntegrationNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindDBShardGroupByID(ctx context.Context, conn *rds.RDS, id string) (*rds.DBShardGroup, error) {
+	input := &rds.DescribeDBShardGroupsInput{
+		DBShardGroupIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeDBShardGroupsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBShardGroupNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBShardGroups) == 0 || output.DBShardGroups[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.DBShardGroups); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	dbShardGroup := output.DBShardGroups[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(dbShardGroup.DBShardGroupIdentifier) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return dbShardGroup, nil
+}
@@ -131,6 +131,28 @@ func flattenServerlessV2ScalingConfigurationInfo(apiObject *rds.ServerlessV2Scal
 	return tfMap
 }
 
+// flattenClusterScalabilityType derives the cluster scalability type from the
+// Aurora Limitless Database details returned for a DB cluster.
+// A limitless cluster without a DB shard group reports the same status as a standard cluster,
+// so in that case the previously known scalability type is kept.
+func flattenClusterScalabilityType(apiObject *rds.LimitlessDatabase, old string) string {
+	var status string
+	if apiObject != nil {
+		status = aws.StringValue(apiObject.Status)
+	}
+
+	switch status {
+	case "", rds.LimitlessDatabaseStatusNotInUse, rds.LimitlessDatabaseStatusDisabled:
+		if old != "" {
+			return old
+		}
+
+		return ClusterScalabilityTypeStandard
+	default:
+		return ClusterScalabilityTypeLimitless
+	}
+}
+
 func expandOptionConfiguration(configured []interface{}) []*rds.OptionConfiguration {
 	var option []*rds.OptionConfiguration
 
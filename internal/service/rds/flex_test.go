@@ -83,3 +83,52 @@ func TestFlattenParameters(t *testing.T) {
 		}
 	}
 }
+
+func TestFlattenClusterScalabilityType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObject *rds.LimitlessDatabase
+		old       string
+		expected  string
+	}{
+		"no limitless database details": {
+			expected: ClusterScalabilityTypeStandard,
+		},
+		"standard not in use": {
+			apiObject: &rds.LimitlessDatabase{Status: aws.String(rds.LimitlessDatabaseStatusNotInUse)},
+			expected:  ClusterScalabilityTypeStandard,
+		},
+		"limitless not in use": {
+			apiObject: &rds.LimitlessDatabase{Status: aws.String(rds.LimitlessDatabaseStatusNotInUse)},
+			old:       ClusterScalabilityTypeLimitless,
+			expected:  ClusterScalabilityTypeLimitless,
+		},
+		"limitless disabled": {
+			apiObject: &rds.LimitlessDatabase{Status: aws.String(rds.LimitlessDatabaseStatusDisabled)},
+			old:       ClusterScalabilityTypeLimitless,
+			expected:  ClusterScalabilityTypeLimitless,
+		},
+		"limitless active": {
+			apiObject: &rds.LimitlessDatabase{Status: aws.String(rds.LimitlessDatabaseStatusActive)},
+			expected:  ClusterScalabilityTypeLimitless,
+		},
+		"limitless active overrides standard": {
+			apiObject: &rds.LimitlessDatabase{Status: aws.String(rds.LimitlessDatabaseStatusActive)},
+			old:       ClusterScalabilityTypeStandard,
+			expected:  ClusterScalabilityTypeLimitless,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := flattenClusterScalabilityType(testCase.apiObject, testCase.old); got != testCase.expected {
+				t.Errorf("flattenClusterScalabilityType() = %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
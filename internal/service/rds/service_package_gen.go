@@ -228,6 +228,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGlobalCluster,
 			TypeName: "aws_rds_global_cluster",
 		},
+		{
+			Factory:  ResourceIntegration,
+			TypeName: "aws_rds_integration",
+			Name:     "Integration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceReservedInstance,
 			TypeName: "aws_rds_reserved_instance",
@@ -236,6 +244,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceShardGroup,
+			TypeName: "aws_rds_shard_group",
+			Name:     "Shard Group",
+		},
 	}
 }
 
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_rds_shard_group", name="Shard Group")
+func ResourceShardGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceShardGroupCreate,
+		ReadWithoutTimeout:   resourceShardGroupRead,
+		UpdateWithoutTimeout: resourceShardGroupUpdate,
+		DeleteWithoutTimeout: resourceShardGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"compute_redundancy": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 2),
+			},
+			"db_cluster_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validIdentifier,
+			},
+			"db_shard_group_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validIdentifier,
+			},
+			"db_shard_group_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_acu": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceShardGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	id := d.Get("db_shard_group_identifier").(string)
+	input := &rds.CreateDBShardGroupInput{
+		DBClusterIdentifier:    aws.String(d.Get("db_cluster_identifier").(string)),
+		DBShardGroupIdentifier: aws.String(id),
+		MaxACU:                 aws.Float64(d.Get("max_acu").(float64)),
+	}
+
+	if v, ok := d.GetOkExists("compute_redundancy"); ok {
+		input.ComputeRedundancy = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOkExists("publicly_accessible"); ok {
+		input.PubliclyAccessible = aws.Bool(v.(bool))
+	}
+
+	_, err := conn.CreateDBShardGroupWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Shard Group (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitDBShardGroupCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Shard Group (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceShardGroupRead(ctx, d, meta)...)
+}
+
+func resourceShardGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	shardGroup, err := FindDBShardGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Shard Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Shard Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("compute_redundancy", shardGroup.ComputeRedundancy)
+	d.Set("db_cluster_identifier", shardGroup.DBClusterIdentifier)
+	d.Set("db_shard_group_identifier", shardGroup.DBShardGroupIdentifier)
+	d.Set("db_shard_group_resource_id", shardGroup.DBShardGroupResourceId)
+	d.Set("endpoint", shardGroup.Endpoint)
+	d.Set("max_acu", shardGroup.MaxACU)
+	d.Set("publicly_accessible", shardGroup.PubliclyAccessible)
+
+	return diags
+}
+
+func resourceShardGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	if d.HasChange("max_acu") {
+		input := &rds.ModifyDBShardGroupInput{
+			DBShardGroupIdentifier: aws.String(d.Id()),
+			MaxACU:                 aws.Float64(d.Get("max_acu").(float64)),
+		}
+
+		_, err := conn.ModifyDBShardGroupWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying RDS Shard Group (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDBShardGroupUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RDS Shard Group (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceShardGroupRead(ctx, d, meta)...)
+}
+
+func resourceShardGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	log.Printf("[DEBUG] Deleting RDS Shard Group: %s", d.Id())
+	_, err := conn.DeleteDBShardGroupWithContext(ctx, &rds.DeleteDBShardGroupInput{
+		DBShardGroupIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBShardGroupNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS Shard Group (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDBShardGroupDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Shard Group (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -156,3 +156,35 @@ func statusDBSnapshot(ctx context.Context, conn *rds.RDS, id string) retry.State
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusIntegration(ctx context.Context, conn *rds.RDS, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindIntegrationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusDBShardGroup(ctx context.Context, conn *rds.RDS, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDBShardGroupByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -5,11 +5,15 @@ package rds
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitEventSubscriptionCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
@@ -356,3 +360,148 @@ func waitDBSnapshotCreated(ctx context.Context, conn *rds.RDS, id string, timeou
 
 	return err
 }
+
+func waitIntegrationCreated(ctx context.Context, conn *rds.RDS, arn string, timeout time.Duration) (*rds.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{rds.IntegrationStatusCreating, rds.IntegrationStatusModifying},
+		Target:     []string{rds.IntegrationStatusActive},
+		Refresh:    statusIntegration(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.Integration); ok {
+		tfresource.SetLastError(err, integrationError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationUpdated(ctx context.Context, conn *rds.RDS, arn string, timeout time.Duration) (*rds.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{rds.IntegrationStatusModifying},
+		Target:                    []string{rds.IntegrationStatusActive},
+		Refresh:                   statusIntegration(ctx, conn, arn),
+		Timeout:                   timeout,
+		MinTimeout:                10 * time.Second,
+		Delay:                     30 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.Integration); ok {
+		tfresource.SetLastError(err, integrationError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationDeleted(ctx context.Context, conn *rds.RDS, arn string, timeout time.Duration) (*rds.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{rds.IntegrationStatusActive, rds.IntegrationStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusIntegration(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.Integration); ok {
+		tfresource.SetLastError(err, integrationError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// integrationError returns the reasons reported by RDS for a failed integration.
+func integrationError(apiObject *rds.Integration) error {
+	if apiObject == nil || aws.StringValue(apiObject.Status) != rds.IntegrationStatusFailed {
+		return nil
+	}
+
+	var errs []error
+
+	for _, v := range apiObject.Errors {
+		if v == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage)))
+	}
+
+	if len(errs) == 0 {
+		return errors.New(aws.StringValue(apiObject.Status))
+	}
+
+	return errors.Join(errs...)
+}
+
+func waitDBShardGroupCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBShardGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{DBShardGroupStatusCreating},
+		Target:     []string{DBShardGroupStatusAvailable},
+		Refresh:    statusDBShardGroup(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBShardGroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBShardGroupUpdated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBShardGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{DBShardGroupStatusModifying},
+		Target:                    []string{DBShardGroupStatusAvailable},
+		Refresh:                   statusDBShardGroup(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                10 * time.Second,
+		Delay:                     30 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBShardGroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBShardGroupDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBShardGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{DBShardGroupStatusAvailable, DBShardGroupStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusDBShardGroup(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBShardGroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
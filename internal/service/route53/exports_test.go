@@ -5,9 +5,12 @@ package route53
 
 // Exports for use in tests only.
 var (
-	CIDRLocationParseResourceID  = cidrLocationParseResourceID
-	FindCIDRCollectionByID       = findCIDRCollectionByID
-	FindCIDRLocationByTwoPartKey = findCIDRLocationByTwoPartKey
-	ResourceCIDRCollection       = newResourceCIDRCollection
-	ResourceCIDRLocation         = newResourceCIDRLocation
+	CIDRLocationParseResourceID     = cidrLocationParseResourceID
+	FindCIDRCollectionByID          = findCIDRCollectionByID
+	FindCIDRLocationByTwoPartKey    = findCIDRLocationByTwoPartKey
+	FindResourceRecordSetsExclusive = findResourceRecordSetsExclusive
+	RecordsExclusiveHash            = recordsExclusiveResourceRecordSetHash
+	ResourceCIDRCollection          = newResourceCIDRCollection
+	ResourceCIDRLocation            = newResourceCIDRLocation
+	ResourceRecordsExclusive        = resourceRecordsExclusive
 )
@@ -0,0 +1,530 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Route 53 allows at most 1,000 ResourceRecord elements in a single change batch.
+	// UPSERT changes count twice.
+	recordsExclusiveMaxResourceRecordsPerChangeBatch = 1000
+)
+
+// @SDKResource("aws_route53_records_exclusive", name="Records Exclusive")
+func resourceRecordsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRecordsExclusivePut,
+		ReadWithoutTimeout:   resourceRecordsExclusiveRead,
+		UpdateWithoutTimeout: resourceRecordsExclusivePut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_record_set": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     resourceRecordsExclusiveResourceRecordSet(),
+				Set:      recordsExclusiveResourceRecordSetHash,
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 32),
+			},
+		},
+	}
+}
+
+func resourceRecordsExclusiveResourceRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"evaluate_target_health": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"name": {
+							Type:      schema.TypeString,
+							Required:  true,
+							StateFunc: NormalizeAliasName,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return strings.EqualFold(old, new)
+							},
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"failover_routing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.ResourceRecordSetFailover_Values(), false),
+						},
+					},
+				},
+			},
+			"health_check_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			"latency_routing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.ResourceRecordSetRegion_Values(), false),
+						},
+					},
+				},
+			},
+			"multivalue_answer_routing_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				StateFunc: func(v interface{}) string {
+					// Route 53 returns names fully qualified and with special characters escaped.
+					return normalizeRecordsExclusiveName(v.(string))
+				},
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"records": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 4000),
+				},
+			},
+			"set_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+			},
+			"weighted_routing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 255),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceRecordsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	zone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	current, err := findResourceRecordSetsExclusive(ctx, conn, zoneID, aws.StringValue(zone.HostedZone.Name))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records Exclusive (%s): %s", zoneID, err)
+	}
+
+	changes := expandRecordsExclusiveChanges(current, d.Get("resource_record_set").(*schema.Set).List())
+
+	for _, batch := range chunkRecordsExclusiveChanges(changes) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: batch,
+				Comment: aws.String("Managed by Terraform"),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		log.Printf("[DEBUG] Applying %d Route 53 Records Exclusive (%s) changes", len(batch), zoneID)
+		changeInfo, err := ChangeResourceRecordSets(ctx, conn, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route 53 Records Exclusive (%s): %s", zoneID, err)
+		}
+
+		if err := WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Records Exclusive (%s) update: %s", zoneID, err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(zoneID)
+	}
+
+	return append(diags, resourceRecordsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRecordsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zone, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) not found, removing Records Exclusive from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	recordSets, err := findResourceRecordSetsExclusive(ctx, conn, d.Id(), aws.StringValue(zone.HostedZone.Name))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records Exclusive (%s): %s", d.Id(), err)
+	}
+
+	tfList := make([]interface{}, 0, len(recordSets))
+	for _, apiObject := range recordSets {
+		tfList = append(tfList, flattenRecordsExclusiveResourceRecordSet(apiObject))
+	}
+	if err := d.Set("resource_record_set", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_record_set: %s", err)
+	}
+	d.Set("zone_id", d.Id())
+
+	return diags
+}
+
+// findResourceRecordSetsExclusive returns all record sets in the specified hosted zone
+// except the SOA and NS records at the zone apex, which Route 53 manages.
+func findResourceRecordSetsExclusive(ctx context.Context, conn *route53.Route53, zoneID, zoneName string) ([]*route53.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	var output []*route53.ResourceRecordSet
+
+	zoneName = strings.TrimSuffix(strings.ToLower(zoneName), ".")
+	err := conn.ListResourceRecordSetsPagesWithContext(ctx, input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceRecordSets {
+			if v == nil {
+				continue
+			}
+
+			if name, typ := normalizeRecordsExclusiveName(aws.StringValue(v.Name)), aws.StringValue(v.Type); name == zoneName && (typ == route53.RRTypeNs || typ == route53.RRTypeSoa) {
+				continue
+			}
+
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// expandRecordsExclusiveChanges returns the changes required to make the hosted zone's record sets
+// match the configured record sets.
+// Record sets that are not configured are deleted and configured record sets that differ are upserted.
+// Deletions are ordered first so that a record set can change its set identifier in a single apply.
+func expandRecordsExclusiveChanges(current []*route53.ResourceRecordSet, tfList []interface{}) []*route53.Change {
+	type key struct {
+		name, typ, setIdentifier string
+	}
+	keyOf := func(tfMap map[string]interface{}) key {
+		return key{tfMap["name"].(string), tfMap["type"].(string), tfMap["set_identifier"].(string)}
+	}
+
+	desired := make(map[key]map[string]interface{})
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		// Round-trip through the API representation so that configured and actual values compare equally.
+		tfMap = flattenRecordsExclusiveResourceRecordSet(expandRecordsExclusiveResourceRecordSet(tfMap))
+		desired[keyOf(tfMap)] = tfMap
+	}
+
+	var deletes, upserts []*route53.Change
+	existing := make(map[key]map[string]interface{})
+	for _, apiObject := range current {
+		tfMap := flattenRecordsExclusiveResourceRecordSet(apiObject)
+		k := keyOf(tfMap)
+		existing[k] = tfMap
+
+		if _, ok := desired[k]; !ok {
+			deletes = append(deletes, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: apiObject,
+			})
+		}
+	}
+
+	for k, tfMap := range desired {
+		if v, ok := existing[k]; ok && reflect.DeepEqual(v, tfMap) {
+			continue
+		}
+
+		upserts = append(upserts, &route53.Change{
+			Action:            aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: expandRecordsExclusiveResourceRecordSet(tfMap),
+		})
+	}
+
+	sort.SliceStable(upserts, func(i, j int) bool {
+		return aws.StringValue(upserts[i].ResourceRecordSet.Name) < aws.StringValue(upserts[j].ResourceRecordSet.Name)
+	})
+
+	return append(deletes, upserts...)
+}
+
+// chunkRecordsExclusiveChanges splits changes into batches that fit within the Route 53 change batch limits.
+func chunkRecordsExclusiveChanges(changes []*route53.Change) [][]*route53.Change {
+	var chunks [][]*route53.Change
+	var chunk []*route53.Change
+	var size int
+
+	for _, change := range changes {
+		n := len(change.ResourceRecordSet.ResourceRecords)
+		if n == 0 {
+			n = 1
+		}
+		if aws.StringValue(change.Action) == route53.ChangeActionUpsert {
+			n *= 2
+		}
+
+		if size+n > recordsExclusiveMaxResourceRecordsPerChangeBatch && len(chunk) > 0 {
+			chunks = append(chunks, chunk)
+			chunk, size = nil, 0
+		}
+
+		chunk = append(chunk, change)
+		size += n
+	}
+
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+func normalizeRecordsExclusiveName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(CleanRecordName(name)), ".")
+}
+
+// recordsExclusiveResourceRecordSetHash hashes a record set using its normalized names,
+// so that configured names that differ only in case or a trailing period match the state.
+func recordsExclusiveResourceRecordSetHash(v interface{}) int {
+	tfMap := make(map[string]interface{})
+	for k, v := range v.(map[string]interface{}) {
+		tfMap[k] = v
+	}
+
+	if v, ok := tfMap["name"].(string); ok {
+		tfMap["name"] = normalizeRecordsExclusiveName(v)
+	}
+
+	if v, ok := tfMap["alias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		alias := make(map[string]interface{})
+		for k, v := range v[0].(map[string]interface{}) {
+			alias[k] = v
+		}
+
+		if v, ok := alias["name"].(string); ok {
+			alias["name"] = NormalizeAliasName(v)
+		}
+
+		tfMap["alias"] = []interface{}{alias}
+	}
+
+	return schema.HashResource(resourceRecordsExclusiveResourceRecordSet())(tfMap)
+}
+
+func expandRecordsExclusiveResourceRecordSet(tfMap map[string]interface{}) *route53.ResourceRecordSet {
+	typ := tfMap["type"].(string)
+	apiObject := &route53.ResourceRecordSet{
+		Name: aws.String(tfMap["name"].(string)),
+		Type: aws.String(typ),
+	}
+
+	if v, ok := tfMap["alias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		alias := v[0].(map[string]interface{})
+		apiObject.AliasTarget = &route53.AliasTarget{
+			DNSName:              aws.String(alias["name"].(string)),
+			EvaluateTargetHealth: aws.Bool(alias["evaluate_target_health"].(bool)),
+			HostedZoneId:         aws.String(alias["zone_id"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["failover_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Failover = aws.String(v[0].(map[string]interface{})["type"].(string))
+	}
+
+	if v, ok := tfMap["health_check_id"].(string); ok && v != "" {
+		apiObject.HealthCheckId = aws.String(v)
+	}
+
+	if v, ok := tfMap["latency_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Region = aws.String(v[0].(map[string]interface{})["region"].(string))
+	}
+
+	if v, ok := tfMap["multivalue_answer_routing_policy"].(bool); ok && v {
+		apiObject.MultiValueAnswer = aws.Bool(v)
+	}
+
+	switch v := tfMap["records"].(type) {
+	case *schema.Set:
+		if v.Len() > 0 {
+			apiObject.ResourceRecords = expandResourceRecords(v.List(), typ)
+		}
+	case []interface{}:
+		if len(v) > 0 {
+			apiObject.ResourceRecords = expandResourceRecords(v, typ)
+		}
+	}
+
+	if v, ok := tfMap["set_identifier"].(string); ok && v != "" {
+		apiObject.SetIdentifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["ttl"].(int); ok && v != 0 {
+		apiObject.TTL = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["weighted_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Weight = aws.Int64(int64(v[0].(map[string]interface{})["weight"].(int)))
+	}
+
+	return apiObject
+}
+
+// flattenRecordsExclusiveResourceRecordSet returns a normalized representation of a record set.
+// All attributes are always present so that two representations can be compared directly.
+func flattenRecordsExclusiveResourceRecordSet(apiObject *route53.ResourceRecordSet) map[string]interface{} {
+	typ := aws.StringValue(apiObject.Type)
+	tfMap := map[string]interface{}{
+		"alias":                            []interface{}{},
+		"failover_routing_policy":          []interface{}{},
+		"health_check_id":                  aws.StringValue(apiObject.HealthCheckId),
+		"latency_routing_policy":           []interface{}{},
+		"multivalue_answer_routing_policy": aws.BoolValue(apiObject.MultiValueAnswer),
+		"name":                             normalizeRecordsExclusiveName(aws.StringValue(apiObject.Name)),
+		"set_identifier":                   aws.StringValue(apiObject.SetIdentifier),
+		"ttl":                              int(aws.Int64Value(apiObject.TTL)),
+		"type":                             typ,
+		"weighted_routing_policy":          []interface{}{},
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias"] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+			"name":                   NormalizeAliasName(aws.StringValue(v.DNSName)),
+			"zone_id":                aws.StringValue(v.HostedZoneId),
+		}}
+	}
+
+	if v := apiObject.Failover; v != nil {
+		tfMap["failover_routing_policy"] = []interface{}{map[string]interface{}{
+			"type": aws.StringValue(v),
+		}}
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["latency_routing_policy"] = []interface{}{map[string]interface{}{
+			"region": aws.StringValue(v),
+		}}
+	}
+
+	records := FlattenResourceRecords(apiObject.ResourceRecords, typ)
+	sort.Strings(records)
+	tfRecords := make([]interface{}, 0, len(records))
+	for _, v := range records {
+		tfRecords = append(tfRecords, v)
+	}
+	tfMap["records"] = tfRecords
+
+	if v := apiObject.Weight; v != nil {
+		tfMap["weighted_routing_policy"] = []interface{}{map[string]interface{}{
+			"weight": int(aws.Int64Value(v)),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+)
+
+func TestRecordsExclusiveHash(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b  map[string]interface{}
+		equal bool
+	}{
+		"identical": {
+			a:     map[string]interface{}{"name": "www.example.com", "type": "A"},
+			b:     map[string]interface{}{"name": "www.example.com", "type": "A"},
+			equal: true,
+		},
+		"case and trailing period": {
+			a:     map[string]interface{}{"name": "WWW.Example.com.", "type": "A"},
+			b:     map[string]interface{}{"name": "www.example.com", "type": "A"},
+			equal: true,
+		},
+		"escaped wildcard": {
+			a:     map[string]interface{}{"name": "*.example.com", "type": "A"},
+			b:     map[string]interface{}{"name": `\052.example.com.`, "type": "A"},
+			equal: true,
+		},
+		"alias name": {
+			a: map[string]interface{}{"name": "www.example.com", "type": "A", "alias": []interface{}{map[string]interface{}{
+				"evaluate_target_health": true,
+				"name":                   "Target.Example.com.",
+				"zone_id":                "Z123",
+			}}},
+			b: map[string]interface{}{"name": "www.example.com", "type": "A", "alias": []interface{}{map[string]interface{}{
+				"evaluate_target_health": true,
+				"name":                   "target.example.com",
+				"zone_id":                "Z123",
+			}}},
+			equal: true,
+		},
+		"different names": {
+			a:     map[string]interface{}{"name": "www.example.com", "type": "A"},
+			b:     map[string]interface{}{"name": "api.example.com", "type": "A"},
+			equal: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfroute53.RecordsExclusiveHash(testCase.a) == tfroute53.RecordsExclusiveHash(testCase.b), testCase.equal; got != want {
+				t.Errorf("hashes equal = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestAccRoute53RecordsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomain()
+	recordName := zoneName.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String(), recordName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"name":      recordName.String(),
+						"records.#": "2",
+						"ttl":       "30",
+						"type":      "A",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"name":      recordName.String(),
+						"records.#": "1",
+						"ttl":       "300",
+						"type":      "TXT",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsExclusive_nameNormalization(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomain()
+	recordName := zoneName.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String(), strings.ToUpper(recordName.String())+"."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"name": recordName.String(),
+						"type": "A",
+					}),
+				),
+			},
+			{
+				Config:   testAccRecordsExclusiveConfig_basic(zoneName.String(), strings.ToUpper(recordName.String())+"."),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsExclusive_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomain()
+	recordName := zoneName.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String(), recordName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+				),
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_weighted(zoneName.String(), recordName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"name":                             recordName.String(),
+						"set_identifier":                   "blue",
+						"type":                             "A",
+						"weighted_routing_policy.#":        "1",
+						"weighted_routing_policy.0.weight": "90",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"name":                             recordName.String(),
+						"set_identifier":                   "green",
+						"type":                             "A",
+						"weighted_routing_policy.#":        "1",
+						"weighted_routing_policy.0.weight": "10",
+					}),
+				),
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_empty(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomain()
+	recordName := zoneName.RandomSubdomain()
+	outOfBandRecordName := zoneName.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String(), recordName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					testAccCheckRecordsExclusiveAddRecordOutOfBand(ctx, resourceName, outOfBandRecordName.String()),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String(), recordName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecordsExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		zone, err := tfroute53.FindHostedZoneByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfroute53.FindResourceRecordSetsExclusive(ctx, conn, rs.Primary.ID, aws.StringValue(zone.HostedZone.Name))
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := strconv.Itoa(len(output)), rs.Primary.Attributes["resource_record_set.#"]; got != want {
+			return fmt.Errorf("Route 53 Records Exclusive (%s) record set count = %s, want %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsExclusiveAddRecordOutOfBand(ctx context.Context, n, recordName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: []*route53.Change{{
+					Action: aws.String(route53.ChangeActionCreate),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name:            aws.String(recordName),
+						ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("127.0.0.99")}},
+						TTL:             aws.Int64(60),
+						Type:            aws.String(route53.RRTypeA),
+					},
+				}},
+			},
+			HostedZoneId: aws.String(rs.Primary.ID),
+		}
+
+		changeInfo, err := tfroute53.ChangeResourceRecordSets(ctx, conn, input)
+
+		if err != nil {
+			return err
+		}
+
+		return tfroute53.WaitForRecordSetToSync(ctx, conn, tfroute53.CleanChangeID(aws.StringValue(changeInfo.Id)))
+	}
+}
+
+func testAccRecordsExclusiveConfig_base(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+`, zoneName)
+}
+
+func testAccRecordsExclusiveConfig_basic(zoneName, recordName string) string {
+	return acctest.ConfigCompose(testAccRecordsExclusiveConfig_base(zoneName), fmt.Sprintf(`
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set {
+    name    = %[1]q
+    type    = "A"
+    ttl     = 30
+    records = ["127.0.0.1", "127.0.0.27"]
+  }
+
+  resource_record_set {
+    name    = %[1]q
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+}
+`, recordName))
+}
+
+func testAccRecordsExclusiveConfig_weighted(zoneName, recordName string) string {
+	return acctest.ConfigCompose(testAccRecordsExclusiveConfig_base(zoneName), fmt.Sprintf(`
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set {
+    name           = %[1]q
+    type           = "A"
+    ttl            = 30
+    records        = ["127.0.0.1"]
+    set_identifier = "blue"
+
+    weighted_routing_policy {
+      weight = 90
+    }
+  }
+
+  resource_record_set {
+    name           = %[1]q
+    type           = "A"
+    ttl            = 30
+    records        = ["127.0.0.2"]
+    set_identifier = "green"
+
+    weighted_routing_policy {
+      weight = 10
+    }
+  }
+}
+`, recordName))
+}
+
+func testAccRecordsExclusiveConfig_empty(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsExclusiveConfig_base(zoneName), `
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+}
+`)
+}
@@ -75,6 +75,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceRecord,
 			TypeName: "aws_route53_record",
 		},
+		{
+			Factory:  resourceRecordsExclusive,
+			TypeName: "aws_route53_records_exclusive",
+			Name:     "Records Exclusive",
+		},
 		{
 			Factory:  ResourceTrafficPolicy,
 			TypeName: "aws_route53_traffic_policy",
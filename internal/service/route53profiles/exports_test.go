@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53profiles
+
+// Exports for use in tests only.
+var (
+	FindAssociationByID         = findAssociationByID
+	FindProfileByID             = findProfileByID
+	FindResourceAssociationByID = findResourceAssociationByID
+
+	ResourceAssociation         = resourceAssociation
+	ResourceProfile             = resourceProfile
+	ResourceResourceAssociation = resourceResourceAssociation
+)
@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53profiles
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53profiles"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53profiles/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_route53profiles_resource_association", name="Resource Association")
+func resourceResourceAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceAssociationCreate,
+		ReadWithoutTimeout:   resourceResourceAssociationRead,
+		UpdateWithoutTimeout: resourceResourceAssociationUpdate,
+		DeleteWithoutTimeout: resourceResourceAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_properties": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				Computed:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceResourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53ProfilesClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &route53profiles.AssociateResourceToProfileInput{
+		Name:        aws.String(name),
+		ProfileId:   aws.String(d.Get("profile_id").(string)),
+		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("resource_properties"); ok {
+		input.ResourceProperties = aws.String(v.(string))
+	}
+
+	output, err := conn.AssociateResourceToProfile(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Route 53 Profile Resource Association (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ProfileResourceAssociation.Id))
+
+	if _, err := waitResourceAssociationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Route 53 Profile Resource Association (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceResourceAssociationRead(ctx, d, meta)
+}
+
+func resourceResourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53ProfilesClient(ctx)
+
+	association, err := findResourceAssociationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Profile Resource Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Route 53 Profile Resource Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrName, association.Name)
+	d.Set("owner_id", association.OwnerId)
+	d.Set("profile_id", association.ProfileId)
+	d.Set("resource_arn", association.ResourceArn)
+	d.Set("resource_properties", association.ResourceProperties)
+	d.Set("resource_type", association.ResourceType)
+	d.Set("status", association.Status)
+	d.Set("status_message", association.StatusMessage)
+
+	return nil
+}
+
+func resourceResourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53ProfilesClient(ctx)
+
+	input := &route53profiles.UpdateProfileResourceAssociationInput{
+		ProfileResourceAssociationId: aws.String(d.Id()),
+	}
+
+	if d.HasChange(names.AttrName) {
+		input.Name = aws.String(d.Get(names.AttrName).(string))
+	}
+
+	if d.HasChange("resource_properties") {
+		input.ResourceProperties = aws.String(d.Get("resource_properties").(string))
+	}
+
+	_, err := conn.UpdateProfileResourceAssociation(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Route 53 Profile Resource Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitResourceAssociationUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("waiting for Route 53 Profile Resource Association (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceResourceAssociationRead(ctx, d, meta)
+}
+
+func resourceResourceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53ProfilesClient(ctx)
+
+	log.Printf("[INFO] Deleting Route 53 Profile Resource Association: %s", d.Id())
+	_, err := conn.DisassociateResourceFromProfile(ctx, &route53profiles.DisassociateResourceFromProfileInput{
+		ProfileId:   aws.String(d.Get("profile_id").(string)),
+		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Route 53 Profile Resource Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitResourceAssociationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Route 53 Profile Resource Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findResourceAssociationByID(ctx context.Context, conn *route53profiles.Client, id string) (*awstypes.ProfileResourceAssociation, error) {
+	input := &route53profiles.GetProfileResourceAssociationInput{
+		ProfileResourceAssociationId: aws.String(id),
+	}
+
+	output, err := conn.GetProfileResourceAssociation(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ProfileResourceAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.ProfileResourceAssociation.Status; status == awstypes.ProfileStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.ProfileResourceAssociation, nil
+}
+
+func statusResourceAssociation(ctx context.Context, conn *route53profiles.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findResourceAssociationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitResourceAssociationCreated(ctx context.Context, conn *route53profiles.Client, id string, timeout time.Duration) (*awstypes.ProfileResourceAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProfileStatusCreating),
+		Target:  enum.Slice(awstypes.ProfileStatusComplete),
+		Refresh: statusResourceAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ProfileResourceAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitResourceAssociationUpdated(ctx context.Context, conn *route53profiles.Client, id string, timeout time.Duration) (*awstypes.ProfileResourceAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProfileStatusUpdating),
+		Target:  enum.Slice(awstypes.ProfileStatusComplete),
+		Refresh: statusResourceAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ProfileResourceAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitResourceAssociationDeleted(ctx context.Context, conn *route53profiles.Client, id string, timeout time.Duration) (*awstypes.ProfileResourceAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProfileStatusComplete, awstypes.ProfileStatusDeleting),
+		Target:  []string{},
+		Refresh: statusResourceAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ProfileResourceAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
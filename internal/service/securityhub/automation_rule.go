@@ -0,0 +1,818 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_securityhub_automation_rule", name="Automation Rule")
+// @Tags(identifierAttribute="arn")
+func ResourceAutomationRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAutomationRuleCreate,
+		ReadWithoutTimeout:   resourceAutomationRuleRead,
+		UpdateWithoutTimeout: resourceAutomationRuleUpdate,
+		DeleteWithoutTimeout: resourceAutomationRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"finding_fields_update": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"confidence": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"criticality": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"note": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"text": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"updated_by": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"related_findings": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"product_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"severity": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"label": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(securityhub.SeverityLabel_Values(), false),
+												},
+												"product": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidTypeStringNullableFloat,
+												},
+											},
+										},
+									},
+									"types": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"user_defined_fields": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"verification_state": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(securityhub.VerificationState_Values(), false),
+									},
+									"workflow": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(securityhub.WorkflowStatus_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      securityhub.AutomationRulesActionTypeFindingFieldsUpdate,
+							ValidateFunc: validation.StringInSlice(securityhub.AutomationRulesActionType_Values(), false),
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_account_id":                     stringFilterSchema(),
+						"aws_account_name":                   stringFilterSchema(),
+						"company_name":                       stringFilterSchema(),
+						"compliance_associated_standards_id": stringFilterSchema(),
+						"compliance_security_control_id":     stringFilterSchema(),
+						"compliance_status":                  stringFilterSchema(),
+						"confidence":                         numberFilterSchema(),
+						"created_at":                         dateFilterSchema(),
+						"criticality":                        numberFilterSchema(),
+						"description":                        stringFilterSchema(),
+						"first_observed_at":                  dateFilterSchema(),
+						"generator_id":                       stringFilterSchema(),
+						"id":                                 stringFilterSchema(),
+						"last_observed_at":                   dateFilterSchema(),
+						"note_text":                          stringFilterSchema(),
+						"note_updated_at":                    dateFilterSchema(),
+						"note_updated_by":                    stringFilterSchema(),
+						"product_arn":                        stringFilterSchema(),
+						"product_name":                       stringFilterSchema(),
+						"record_state":                       stringFilterSchema(),
+						"related_findings_id":                stringFilterSchema(),
+						"related_findings_product_arn":       stringFilterSchema(),
+						"resource_application_arn":           stringFilterSchema(),
+						"resource_application_name":          stringFilterSchema(),
+						"resource_details_other":             mapFilterSchema(),
+						"resource_id":                        stringFilterSchema(),
+						"resource_partition":                 stringFilterSchema(),
+						"resource_region":                    stringFilterSchema(),
+						"resource_tags":                      mapFilterSchema(),
+						"resource_type":                      stringFilterSchema(),
+						"severity_label":                     stringFilterSchema(),
+						"source_url":                         stringFilterSchema(),
+						"title":                              stringFilterSchema(),
+						"type":                               stringFilterSchema(),
+						"updated_at":                         dateFilterSchema(),
+						"user_defined_fields":                mapFilterSchema(),
+						"verification_state":                 stringFilterSchema(),
+						"workflow_status":                    workflowStatusSchema(),
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"is_terminal": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rule_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rule_order": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"rule_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(securityhub.RuleStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAutomationRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	name := d.Get("rule_name").(string)
+	input := &securityhub.CreateAutomationRuleInput{
+		Actions:     expandAutomationRulesActions(d.Get("actions").(*schema.Set).List()),
+		Criteria:    expandAutomationRulesFindingFilters(d.Get("criteria").([]interface{})),
+		Description: aws.String(d.Get("description").(string)),
+		IsTerminal:  aws.Bool(d.Get("is_terminal").(bool)),
+		RuleName:    aws.String(name),
+		RuleOrder:   aws.Int64(int64(d.Get("rule_order").(int))),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("rule_status"); ok {
+		input.RuleStatus = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAutomationRuleWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Security Hub Automation Rule (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RuleArn))
+
+	return append(diags, resourceAutomationRuleRead(ctx, d, meta)...)
+}
+
+func resourceAutomationRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	rule, err := FindAutomationRuleByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Automation Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Automation Rule (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("actions", flattenAutomationRulesActions(rule.Actions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting actions: %s", err)
+	}
+	d.Set("arn", rule.RuleArn)
+	if err := d.Set("criteria", flattenAutomationRulesFindingFilters(rule.Criteria)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting criteria: %s", err)
+	}
+	d.Set("description", rule.Description)
+	d.Set("is_terminal", rule.IsTerminal)
+	d.Set("rule_name", rule.RuleName)
+	d.Set("rule_order", rule.RuleOrder)
+	d.Set("rule_status", rule.RuleStatus)
+
+	return diags
+}
+
+func resourceAutomationRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		item := &securityhub.UpdateAutomationRulesRequestItem{
+			RuleArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("actions") {
+			item.Actions = expandAutomationRulesActions(d.Get("actions").(*schema.Set).List())
+		}
+
+		if d.HasChange("criteria") {
+			item.Criteria = expandAutomationRulesFindingFilters(d.Get("criteria").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			item.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("is_terminal") {
+			item.IsTerminal = aws.Bool(d.Get("is_terminal").(bool))
+		}
+
+		if d.HasChange("rule_name") {
+			item.RuleName = aws.String(d.Get("rule_name").(string))
+		}
+
+		if d.HasChange("rule_order") {
+			item.RuleOrder = aws.Int64(int64(d.Get("rule_order").(int)))
+		}
+
+		if d.HasChange("rule_status") {
+			item.RuleStatus = aws.String(d.Get("rule_status").(string))
+		}
+
+		input := &securityhub.BatchUpdateAutomationRulesInput{
+			UpdateAutomationRulesRequestItems: []*securityhub.UpdateAutomationRulesRequestItem{item},
+		}
+
+		output, err := conn.BatchUpdateAutomationRulesWithContext(ctx, input)
+
+		if err == nil && output != nil {
+			err = unprocessedAutomationRulesError(output.UnprocessedAutomationRules)
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Security Hub Automation Rule (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAutomationRuleRead(ctx, d, meta)...)
+}
+
+func resourceAutomationRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Security Hub Automation Rule: %s", d.Id())
+	output, err := conn.BatchDeleteAutomationRulesWithContext(ctx, &securityhub.BatchDeleteAutomationRulesInput{
+		AutomationRulesArns: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err == nil && output != nil {
+		err = unprocessedAutomationRulesError(output.UnprocessedAutomationRules)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Hub Automation Rule (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindAutomationRuleByARN(ctx context.Context, conn *securityhub.SecurityHub, arn string) (*securityhub.AutomationRulesConfig, error) {
+	input := &securityhub.BatchGetAutomationRulesInput{
+		AutomationRulesArns: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.BatchGetAutomationRulesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSinglePtrResult(output.Rules)
+}
+
+func unprocessedAutomationRulesError(apiObjects []*securityhub.UnprocessedAutomationRule) error {
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		return fmt.Errorf("%d: %s", aws.Int64Value(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage))
+	}
+
+	return nil
+}
+
+func expandAutomationRulesActions(tfList []interface{}) []*securityhub.AutomationRulesAction {
+	var apiObjects []*securityhub.AutomationRulesAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &securityhub.AutomationRulesAction{}
+
+		if v, ok := tfMap["finding_fields_update"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.FindingFieldsUpdate = expandAutomationRulesFindingFieldsUpdate(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAutomationRulesFindingFieldsUpdate(tfMap map[string]interface{}) *securityhub.AutomationRulesFindingFieldsUpdate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &securityhub.AutomationRulesFindingFieldsUpdate{}
+
+	if v, ok := tfMap["confidence"].(int); ok && v != 0 {
+		apiObject.Confidence = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["criticality"].(int); ok && v != 0 {
+		apiObject.Criticality = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["note"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Note = &securityhub.NoteUpdate{
+			Text:      aws.String(tfMap["text"].(string)),
+			UpdatedBy: aws.String(tfMap["updated_by"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["related_findings"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.RelatedFindings = append(apiObject.RelatedFindings, &securityhub.RelatedFinding{
+				Id:         aws.String(tfMap["id"].(string)),
+				ProductArn: aws.String(tfMap["product_arn"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["severity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		severity := &securityhub.SeverityUpdate{}
+
+		if v, ok := tfMap["label"].(string); ok && v != "" {
+			severity.Label = aws.String(v)
+		}
+
+		if v, ok := tfMap["product"].(string); ok && v != "" {
+			if v, err := strconv.ParseFloat(v, 64); err == nil {
+				severity.Product = aws.Float64(v)
+			}
+		}
+
+		apiObject.Severity = severity
+	}
+
+	if v, ok := tfMap["types"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Types = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["user_defined_fields"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserDefinedFields = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["verification_state"].(string); ok && v != "" {
+		apiObject.VerificationState = aws.String(v)
+	}
+
+	if v, ok := tfMap["workflow"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Workflow = &securityhub.WorkflowUpdate{
+			Status: aws.String(v[0].(map[string]interface{})["status"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandAutomationRulesFindingFilters(tfList []interface{}) *securityhub.AutomationRulesFindingFilters {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	apiObject := &securityhub.AutomationRulesFindingFilters{}
+
+	if v, ok := tfMap["aws_account_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AwsAccountId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["aws_account_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AwsAccountName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["company_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CompanyName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["compliance_associated_standards_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComplianceAssociatedStandardsId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["compliance_security_control_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComplianceSecurityControlId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["compliance_status"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComplianceStatus = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["confidence"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Confidence = expandNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["created_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CreatedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["criticality"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Criticality = expandNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["description"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Description = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["first_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FirstObservedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["generator_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.GeneratorId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Id = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["last_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LastObservedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["note_text"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NoteText = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["note_updated_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NoteUpdatedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["note_updated_by"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NoteUpdatedBy = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["product_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ProductArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["product_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ProductName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["record_state"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RecordState = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["related_findings_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RelatedFindingsId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["related_findings_product_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RelatedFindingsProductArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_application_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceApplicationArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_application_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceApplicationName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_details_other"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceDetailsOther = expandMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_partition"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourcePartition = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_region"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceRegion = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_tags"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceTags = expandMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["severity_label"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SeverityLabel = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["source_url"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SourceUrl = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["title"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Title = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Type = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["updated_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UpdatedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["user_defined_fields"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UserDefinedFields = expandMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["verification_state"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VerificationState = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["workflow_status"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.WorkflowStatus = expandStringFilters(v.List())
+	}
+
+	return apiObject
+}
+
+func flattenAutomationRulesActions(apiObjects []*securityhub.AutomationRulesAction) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"finding_fields_update": flattenAutomationRulesFindingFieldsUpdate(apiObject.FindingFieldsUpdate),
+			"type":                  aws.StringValue(apiObject.Type),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAutomationRulesFindingFieldsUpdate(apiObject *securityhub.AutomationRulesFindingFieldsUpdate) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"confidence":          aws.Int64Value(apiObject.Confidence),
+		"criticality":         aws.Int64Value(apiObject.Criticality),
+		"types":               aws.StringValueSlice(apiObject.Types),
+		"user_defined_fields": aws.StringValueMap(apiObject.UserDefinedFields),
+		"verification_state":  aws.StringValue(apiObject.VerificationState),
+	}
+
+	if v := apiObject.Note; v != nil {
+		tfMap["note"] = []interface{}{
+			map[string]interface{}{
+				"text":       aws.StringValue(v.Text),
+				"updated_by": aws.StringValue(v.UpdatedBy),
+			},
+		}
+	}
+
+	if v := apiObject.RelatedFindings; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"id":          aws.StringValue(apiObject.Id),
+				"product_arn": aws.StringValue(apiObject.ProductArn),
+			})
+		}
+
+		tfMap["related_findings"] = tfList
+	}
+
+	if v := apiObject.Severity; v != nil {
+		severity := map[string]interface{}{
+			"label": aws.StringValue(v.Label),
+		}
+
+		if v.Product != nil {
+			severity["product"] = strconv.FormatFloat(aws.Float64Value(v.Product), 'f', -1, 64)
+		}
+
+		tfMap["severity"] = []interface{}{severity}
+	}
+
+	if v := apiObject.Workflow; v != nil {
+		tfMap["workflow"] = []interface{}{
+			map[string]interface{}{
+				"status": aws.StringValue(v.Status),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAutomationRulesFindingFilters(apiObject *securityhub.AutomationRulesFindingFilters) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"aws_account_id":                     flattenStringFilters(apiObject.AwsAccountId),
+		"aws_account_name":                   flattenStringFilters(apiObject.AwsAccountName),
+		"company_name":                       flattenStringFilters(apiObject.CompanyName),
+		"compliance_associated_standards_id": flattenStringFilters(apiObject.ComplianceAssociatedStandardsId),
+		"compliance_security_control_id":     flattenStringFilters(apiObject.ComplianceSecurityControlId),
+		"compliance_status":                  flattenStringFilters(apiObject.ComplianceStatus),
+		"confidence":                         flattenNumberFilters(apiObject.Confidence),
+		"created_at":                         flattenDateFilters(apiObject.CreatedAt),
+		"criticality":                        flattenNumberFilters(apiObject.Criticality),
+		"description":                        flattenStringFilters(apiObject.Description),
+		"first_observed_at":                  flattenDateFilters(apiObject.FirstObservedAt),
+		"generator_id":                       flattenStringFilters(apiObject.GeneratorId),
+		"id":                                 flattenStringFilters(apiObject.Id),
+		"last_observed_at":                   flattenDateFilters(apiObject.LastObservedAt),
+		"note_text":                          flattenStringFilters(apiObject.NoteText),
+		"note_updated_at":                    flattenDateFilters(apiObject.NoteUpdatedAt),
+		"note_updated_by":                    flattenStringFilters(apiObject.NoteUpdatedBy),
+		"product_arn":                        flattenStringFilters(apiObject.ProductArn),
+		"product_name":                       flattenStringFilters(apiObject.ProductName),
+		"record_state":                       flattenStringFilters(apiObject.RecordState),
+		"related_findings_id":                flattenStringFilters(apiObject.RelatedFindingsId),
+		"related_findings_product_arn":       flattenStringFilters(apiObject.RelatedFindingsProductArn),
+		"resource_application_arn":           flattenStringFilters(apiObject.ResourceApplicationArn),
+		"resource_application_name":          flattenStringFilters(apiObject.ResourceApplicationName),
+		"resource_details_other":             flattenMapFilters(apiObject.ResourceDetailsOther),
+		"resource_id":                        flattenStringFilters(apiObject.ResourceId),
+		"resource_partition":                 flattenStringFilters(apiObject.ResourcePartition),
+		"resource_region":                    flattenStringFilters(apiObject.ResourceRegion),
+		"resource_tags":                      flattenMapFilters(apiObject.ResourceTags),
+		"resource_type":                      flattenStringFilters(apiObject.ResourceType),
+		"severity_label":                     flattenStringFilters(apiObject.SeverityLabel),
+		"source_url":                         flattenStringFilters(apiObject.SourceUrl),
+		"title":                              flattenStringFilters(apiObject.Title),
+		"type":                               flattenStringFilters(apiObject.Type),
+		"updated_at":                         flattenDateFilters(apiObject.UpdatedAt),
+		"user_defined_fields":                flattenMapFilters(apiObject.UserDefinedFields),
+		"verification_state":                 flattenStringFilters(apiObject.VerificationState),
+		"workflow_status":                    flattenStringFilters(apiObject.WorkflowStatus),
+	}
+
+	return []interface{}{tfMap}
+}
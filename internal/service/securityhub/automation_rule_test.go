@@ -0,0 +1,411 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAutomationRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v securityhub.AutomationRulesConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "actions.*", map[string]string{
+						"finding_fields_update.#":                  "1",
+						"finding_fields_update.0.severity.#":       "1",
+						"finding_fields_update.0.severity.0.label": "LOW",
+						"type": "FINDING_FIELDS_UPDATE",
+					}),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "securityhub", regexp.MustCompile(`automation-rule/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.aws_account_id.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "criteria.0.aws_account_id.*", map[string]string{
+						"comparison": securityhub.StringFilterComparisonEquals,
+						"value":      "1234567890",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "is_terminal", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_order", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v securityhub.AutomationRulesConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_full(rName, "ENABLED", "NOTIFIED", 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "actions.*", map[string]string{
+						"finding_fields_update.0.confidence":              "20",
+						"finding_fields_update.0.criticality":             "80",
+						"finding_fields_update.0.note.0.text":             "example note",
+						"finding_fields_update.0.note.0.updated_by":       "sechub-automation",
+						"finding_fields_update.0.types.#":                 "1",
+						"finding_fields_update.0.types.0":                 "Software and Configuration Checks/Industry and Regulatory Standards",
+						"finding_fields_update.0.user_defined_fields.%":   "1",
+						"finding_fields_update.0.user_defined_fields.key": "value",
+						"finding_fields_update.0.verification_state":      "TRUE_POSITIVE",
+						"finding_fields_update.0.workflow.0.status":       "NOTIFIED",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.confidence.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.created_at.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_tags.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "criteria.0.resource_tags.*", map[string]string{
+						"comparison": securityhub.MapFilterComparisonEquals,
+						"key":        "Environment",
+						"value":      "production",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.severity_label.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "is_terminal", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomationRuleConfig_full(rName, "DISABLED", "RESOLVED", 40),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "actions.*", map[string]string{
+						"finding_fields_update.0.criticality":       "40",
+						"finding_fields_update.0.workflow.0.status": "RESOLVED",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v securityhub.AutomationRulesConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecurityhub.ResourceAutomationRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v securityhub.AutomationRulesConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomationRuleConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAutomationRuleConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationRuleExists(ctx context.Context, n string, v *securityhub.AutomationRulesConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn(ctx)
+
+		output, err := tfsecurityhub.FindAutomationRuleByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAutomationRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securityhub_automation_rule" {
+				continue
+			}
+
+			_, err := tfsecurityhub.FindAutomationRuleByARN(ctx, conn, rs.Primary.ID)
+
+			if tfawserr.ErrMessageContains(err, securityhub.ErrCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+				continue
+			}
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Hub Automation Rule (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAutomationRuleConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    finding_fields_update {
+      severity {
+        label = "LOW"
+      }
+    }
+  }
+
+  criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = "1234567890"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName)
+}
+
+func testAccAutomationRuleConfig_full(rName, ruleStatus, workflowStatus string, criticality int) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  is_terminal = true
+  rule_name   = %[1]q
+  rule_order  = 1
+  rule_status = %[2]q
+
+  actions {
+    finding_fields_update {
+      confidence  = 20
+      criticality = %[4]d
+
+      note {
+        text       = "example note"
+        updated_by = "sechub-automation"
+      }
+
+      types = ["Software and Configuration Checks/Industry and Regulatory Standards"]
+
+      user_defined_fields = {
+        key = "value"
+      }
+
+      verification_state = "TRUE_POSITIVE"
+
+      workflow {
+        status = %[3]q
+      }
+    }
+  }
+
+  criteria {
+    confidence {
+      gte = "20"
+      lte = "80"
+    }
+
+    created_at {
+      date_range {
+        unit  = "DAYS"
+        value = 5
+      }
+    }
+
+    resource_tags {
+      comparison = "EQUALS"
+      key        = "Environment"
+      value      = "production"
+    }
+
+    severity_label {
+      comparison = "EQUALS"
+      value      = "HIGH"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, ruleStatus, workflowStatus, criticality)
+}
+
+func testAccAutomationRuleConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    finding_fields_update {
+      severity {
+        label = "LOW"
+      }
+    }
+  }
+
+  criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = "1234567890"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAutomationRuleConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    finding_fields_update {
+      severity {
+        label = "LOW"
+      }
+    }
+  }
+
+  criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = "1234567890"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
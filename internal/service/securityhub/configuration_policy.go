@@ -0,0 +1,588 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_securityhub_configuration_policy", name="Configuration Policy")
+func ResourceConfigurationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPolicyCreate,
+		ReadWithoutTimeout:   resourceConfigurationPolicyRead,
+		UpdateWithoutTimeout: resourceConfigurationPolicyUpdate,
+		DeleteWithoutTimeout: resourceConfigurationPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled_standard_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+						"security_controls_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disabled_control_identifiers": {
+										Type:          schema.TypeSet,
+										Optional:      true,
+										Elem:          &schema.Schema{Type: schema.TypeString},
+										ConflictsWith: []string{"configuration_policy.0.security_controls_configuration.0.enabled_control_identifiers"},
+									},
+									"enabled_control_identifiers": {
+										Type:          schema.TypeSet,
+										Optional:      true,
+										Elem:          &schema.Schema{Type: schema.TypeString},
+										ConflictsWith: []string{"configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers"},
+									},
+									"security_control_custom_parameter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"parameter": {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bool": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeBool,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+															"double": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: verify.ValidTypeStringNullableFloat,
+																		},
+																	},
+																},
+															},
+															"enum": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+															"enum_list": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeList,
+																			Required: true,
+																			Elem:     &schema.Schema{Type: schema.TypeString},
+																		},
+																	},
+																},
+															},
+															"int": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeInt,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+															"int_list": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeList,
+																			Required: true,
+																			Elem:     &schema.Schema{Type: schema.TypeInt},
+																		},
+																	},
+																},
+															},
+															"name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"string": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+															"string_list": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"value": {
+																			Type:     schema.TypeList,
+																			Required: true,
+																			Elem:     &schema.Schema{Type: schema.TypeString},
+																		},
+																	},
+																},
+															},
+															"value_type": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(securityhub.ParameterValueType_Values(), false),
+															},
+														},
+													},
+												},
+												"security_control_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"service_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceConfigurationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &securityhub.CreateConfigurationPolicyInput{
+		ConfigurationPolicy: expandPolicy(d.Get("configuration_policy").([]interface{})),
+		Name:                aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateConfigurationPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Security Hub Configuration Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return append(diags, resourceConfigurationPolicyRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	output, err := FindConfigurationPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	if err := d.Set("configuration_policy", flattenPolicy(output.ConfigurationPolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration_policy: %s", err)
+	}
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+
+	return diags
+}
+
+func resourceConfigurationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	input := &securityhub.UpdateConfigurationPolicyInput{
+		ConfigurationPolicy: expandPolicy(d.Get("configuration_policy").([]interface{})),
+		Description:         aws.String(d.Get("description").(string)),
+		Identifier:          aws.String(d.Id()),
+		Name:                aws.String(d.Get("name").(string)),
+	}
+
+	_, err := conn.UpdateConfigurationPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceConfigurationPolicyRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Security Hub Configuration Policy: %s", d.Id())
+	_, err := conn.DeleteConfigurationPolicyWithContext(ctx, &securityhub.DeleteConfigurationPolicyInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindConfigurationPolicyByID(ctx context.Context, conn *securityhub.SecurityHub, id string) (*securityhub.GetConfigurationPolicyOutput, error) {
+	input := &securityhub.GetConfigurationPolicyInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetConfigurationPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandPolicy(tfList []interface{}) *securityhub.Policy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &securityhub.SecurityHubPolicy{
+		ServiceEnabled: aws.Bool(tfMap["service_enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["enabled_standard_arns"].(*schema.Set); ok {
+		apiObject.EnabledStandardIdentifiers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["security_controls_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SecurityControlsConfiguration = expandSecurityControlsConfiguration(v[0].(map[string]interface{}))
+	} else if aws.BoolValue(apiObject.ServiceEnabled) {
+		// Security controls must be configured when the service is enabled, default to enabling all of them.
+		apiObject.SecurityControlsConfiguration = &securityhub.SecurityControlsConfiguration{
+			DisabledSecurityControlIdentifiers: []*string{},
+		}
+	}
+
+	return &securityhub.Policy{
+		SecurityHub: apiObject,
+	}
+}
+
+func expandSecurityControlsConfiguration(tfMap map[string]interface{}) *securityhub.SecurityControlsConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &securityhub.SecurityControlsConfiguration{}
+
+	// Exactly one of the two control identifier lists must be sent, even if it is empty.
+	if v, ok := tfMap["enabled_control_identifiers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EnabledSecurityControlIdentifiers = flex.ExpandStringSet(v)
+	} else if v, ok := tfMap["disabled_control_identifiers"].(*schema.Set); ok {
+		apiObject.DisabledSecurityControlIdentifiers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["security_control_custom_parameter"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SecurityControlCustomParameters = expandSecurityControlCustomParameters(v)
+	}
+
+	return apiObject
+}
+
+func expandSecurityControlCustomParameters(tfList []interface{}) []*securityhub.SecurityControlCustomParameter {
+	var apiObjects []*securityhub.SecurityControlCustomParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &securityhub.SecurityControlCustomParameter{
+			SecurityControlId: aws.String(tfMap["security_control_id"].(string)),
+		}
+
+		if v, ok := tfMap["parameter"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Parameters = make(map[string]*securityhub.ParameterConfiguration)
+
+			for _, tfMapRaw := range v.List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				apiObject.Parameters[tfMap["name"].(string)] = expandParameterConfiguration(tfMap)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandParameterConfiguration(tfMap map[string]interface{}) *securityhub.ParameterConfiguration {
+	apiObject := &securityhub.ParameterConfiguration{
+		ValueType: aws.String(tfMap["value_type"].(string)),
+	}
+
+	// Default values must not be sent with a value.
+	if aws.StringValue(apiObject.ValueType) != securityhub.ParameterValueTypeCustom {
+		return apiObject
+	}
+
+	value := &securityhub.ParameterValue{}
+
+	if v, ok := tfMap["bool"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		value.Boolean = aws.Bool(v[0].(map[string]interface{})["value"].(bool))
+	}
+
+	if v, ok := tfMap["double"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, err := strconv.ParseFloat(v[0].(map[string]interface{})["value"].(string), 64); err == nil {
+			value.Double = aws.Float64(v)
+		}
+	}
+
+	if v, ok := tfMap["enum"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		value.Enum = aws.String(v[0].(map[string]interface{})["value"].(string))
+	}
+
+	if v, ok := tfMap["enum_list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		value.EnumList = flex.ExpandStringList(v[0].(map[string]interface{})["value"].([]interface{}))
+	}
+
+	if v, ok := tfMap["int"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		value.Integer = aws.Int64(int64(v[0].(map[string]interface{})["value"].(int)))
+	}
+
+	if v, ok := tfMap["int_list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		value.IntegerList = flex.ExpandInt64List(v[0].(map[string]interface{})["value"].([]interface{}))
+	}
+
+	if v, ok := tfMap["string"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		value.String_ = aws.String(v[0].(map[string]interface{})["value"].(string))
+	}
+
+	if v, ok := tfMap["string_list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		value.StringList = flex.ExpandStringList(v[0].(map[string]interface{})["value"].([]interface{}))
+	}
+
+	apiObject.Value = value
+
+	return apiObject
+}
+
+func flattenPolicy(apiObject *securityhub.Policy) []interface{} {
+	if apiObject == nil || apiObject.SecurityHub == nil {
+		return nil
+	}
+
+	policy := apiObject.SecurityHub
+	tfMap := map[string]interface{}{
+		"enabled_standard_arns":           aws.StringValueSlice(policy.EnabledStandardIdentifiers),
+		"security_controls_configuration": flattenSecurityControlsConfiguration(policy.SecurityControlsConfiguration),
+		"service_enabled":                 aws.BoolValue(policy.ServiceEnabled),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSecurityControlsConfiguration(apiObject *securityhub.SecurityControlsConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"disabled_control_identifiers":      aws.StringValueSlice(apiObject.DisabledSecurityControlIdentifiers),
+		"enabled_control_identifiers":       aws.StringValueSlice(apiObject.EnabledSecurityControlIdentifiers),
+		"security_control_custom_parameter": flattenSecurityControlCustomParameters(apiObject.SecurityControlCustomParameters),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSecurityControlCustomParameters(apiObjects []*securityhub.SecurityControlCustomParameter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var parameters []interface{}
+
+		for name, apiObject := range apiObject.Parameters {
+			if apiObject == nil {
+				continue
+			}
+
+			parameters = append(parameters, flattenParameterConfiguration(name, apiObject))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"parameter":           parameters,
+			"security_control_id": aws.StringValue(apiObject.SecurityControlId),
+		})
+	}
+
+	return tfList
+}
+
+func flattenParameterConfiguration(name string, apiObject *securityhub.ParameterConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"name":       name,
+		"value_type": aws.StringValue(apiObject.ValueType),
+	}
+
+	// Values for default parameters are not configurable.
+	if aws.StringValue(apiObject.ValueType) != securityhub.ParameterValueTypeCustom || apiObject.Value == nil {
+		return tfMap
+	}
+
+	value := apiObject.Value
+
+	if v := value.Boolean; v != nil {
+		tfMap["bool"] = []interface{}{map[string]interface{}{"value": aws.BoolValue(v)}}
+	}
+
+	if v := value.Double; v != nil {
+		tfMap["double"] = []interface{}{map[string]interface{}{"value": strconv.FormatFloat(aws.Float64Value(v), 'f', -1, 64)}}
+	}
+
+	if v := value.Enum; v != nil {
+		tfMap["enum"] = []interface{}{map[string]interface{}{"value": aws.StringValue(v)}}
+	}
+
+	if v := value.EnumList; v != nil {
+		tfMap["enum_list"] = []interface{}{map[string]interface{}{"value": aws.StringValueSlice(v)}}
+	}
+
+	if v := value.Integer; v != nil {
+		tfMap["int"] = []interface{}{map[string]interface{}{"value": aws.Int64Value(v)}}
+	}
+
+	if v := value.IntegerList; v != nil {
+		tfMap["int_list"] = []interface{}{map[string]interface{}{"value": flex.FlattenInt64List(v)}}
+	}
+
+	if v := value.String_; v != nil {
+		tfMap["string"] = []interface{}{map[string]interface{}{"value": aws.StringValue(v)}}
+	}
+
+	if v := value.StringList; v != nil {
+		tfMap["string_list"] = []interface{}{map[string]interface{}{"value": aws.StringValueSlice(v)}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_securityhub_configuration_policy_association", name="Configuration Policy Association")
+func ResourceConfigurationPolicyAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPolicyAssociationCreate,
+		ReadWithoutTimeout:   resourceConfigurationPolicyAssociationRead,
+		UpdateWithoutTimeout: resourceConfigurationPolicyAssociationUpdate,
+		DeleteWithoutTimeout: resourceConfigurationPolicyAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					validation.StringMatch(regexp.MustCompile(`^\d{12}$`), "must be an AWS account ID"),
+					validation.StringMatch(regexp.MustCompile(`^ou-[0-9a-z]{4,32}-[0-9a-z]{8,32}$`), "must be an organizational unit ID"),
+					validation.StringMatch(regexp.MustCompile(`^r-[0-9a-z]{4,32}$`), "must be an organization root ID"),
+				),
+			},
+		},
+	}
+}
+
+func resourceConfigurationPolicyAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	targetID := d.Get("target_id").(string)
+	input := &securityhub.StartConfigurationPolicyAssociationInput{
+		ConfigurationPolicyIdentifier: aws.String(d.Get("policy_id").(string)),
+		Target:                        expandTarget(targetID),
+	}
+
+	_, err := conn.StartConfigurationPolicyAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Security Hub Configuration Policy Association (%s): %s", targetID, err)
+	}
+
+	d.SetId(targetID)
+
+	if _, err := waitConfigurationPolicyAssociationSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Configuration Policy Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceConfigurationPolicyAssociationRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	output, err := FindConfigurationPolicyAssociationByTargetID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policy Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_id", output.ConfigurationPolicyId)
+	d.Set("target_id", output.TargetId)
+
+	return diags
+}
+
+func resourceConfigurationPolicyAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	input := &securityhub.StartConfigurationPolicyAssociationInput{
+		ConfigurationPolicyIdentifier: aws.String(d.Get("policy_id").(string)),
+		Target:                        expandTarget(d.Id()),
+	}
+
+	_, err := conn.StartConfigurationPolicyAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Security Hub Configuration Policy Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitConfigurationPolicyAssociationSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Configuration Policy Association (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceConfigurationPolicyAssociationRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Security Hub Configuration Policy Association: %s", d.Id())
+	_, err := conn.StartConfigurationPolicyDisassociationWithContext(ctx, &securityhub.StartConfigurationPolicyDisassociationInput{
+		ConfigurationPolicyIdentifier: aws.String(d.Get("policy_id").(string)),
+		Target:                        expandTarget(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Hub Configuration Policy Association (%s): %s", d.Id(), err)
+	}
+
+	// After disassociation the target reverts to its inherited (or self-managed) configuration.
+	if _, err := waitConfigurationPolicyAssociationSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Configuration Policy Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindConfigurationPolicyAssociationByTargetID(ctx context.Context, conn *securityhub.SecurityHub, targetID string) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	input := &securityhub.GetConfigurationPolicyAssociationInput{
+		Target: expandTarget(targetID),
+	}
+
+	output, err := conn.GetConfigurationPolicyAssociationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Only associations applied directly to the target are managed by this resource.
+	if aws.StringValue(output.AssociationType) != securityhub.AssociationTypeApplied {
+		return nil, &retry.NotFoundError{
+			Message:     "association is " + aws.StringValue(output.AssociationType),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusConfigurationPolicyAssociation(ctx context.Context, conn *securityhub.SecurityHub, targetID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetConfigurationPolicyAssociationWithContext(ctx, &securityhub.GetConfigurationPolicyAssociationInput{
+			Target: expandTarget(targetID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AssociationStatus), nil
+	}
+}
+
+func waitConfigurationPolicyAssociationSucceeded(ctx context.Context, conn *securityhub.SecurityHub, targetID string, timeout time.Duration) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{securityhub.ConfigurationPolicyAssociationStatusPending},
+		Target:  []string{securityhub.ConfigurationPolicyAssociationStatusSuccess},
+		Refresh: statusConfigurationPolicyAssociation(ctx, conn, targetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*securityhub.GetConfigurationPolicyAssociationOutput); ok {
+		if status := aws.StringValue(output.AssociationStatus); status == securityhub.ConfigurationPolicyAssociationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.AssociationStatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandTarget(targetID string) *securityhub.Target {
+	switch {
+	case strings.HasPrefix(targetID, "ou-"):
+		return &securityhub.Target{
+			OrganizationalUnitId: aws.String(targetID),
+		}
+	case strings.HasPrefix(targetID, "r-"):
+		return &securityhub.Target{
+			RootId: aws.String(targetID),
+		}
+	default:
+		return &securityhub.Target{
+			AccountId: aws.String(targetID),
+		}
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccConfigurationPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v securityhub.GetConfigurationPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyConfig_basic(rName, "Test description", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "securityhub", regexp.MustCompile(`configuration-policy/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.enabled_standard_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.service_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Test description"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationPolicyConfig_basic(rName, "Test description updated", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.enabled_standard_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.service_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Test description updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v securityhub.GetConfigurationPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyConfig_basic(rName, "Test description", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecurityhub.ResourceConfigurationPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccConfigurationPolicy_controlIdentifiers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v securityhub.GetConfigurationPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyConfig_controlIdentifiers(rName, "disabled_control_identifiers"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers.*", "APIGateway.1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers.*", "IAM.7"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.enabled_control_identifiers.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationPolicyConfig_controlIdentifiers(rName, "enabled_control_identifiers"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.enabled_control_identifiers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationPolicy_customParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v securityhub.GetConfigurationPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyConfig_customParameters(rName, 90),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.security_control_custom_parameter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.security_control_custom_parameter.0.security_control_id", "IAM.7"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration_policy.0.security_controls_configuration.0.security_control_custom_parameter.0.parameter.*", map[string]string{
+						"name":        "MaxPasswordAge",
+						"value_type":  "CUSTOM",
+						"int.0.value": "90",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationPolicyConfig_customParameters(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration_policy.0.security_controls_configuration.0.security_control_custom_parameter.0.parameter.*", map[string]string{
+						"name":        "MaxPasswordAge",
+						"value_type":  "CUSTOM",
+						"int.0.value": "60",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigurationPolicyExists(ctx context.Context, n string, v *securityhub.GetConfigurationPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn(ctx)
+
+		output, err := tfsecurityhub.FindConfigurationPolicyByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckConfigurationPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securityhub_configuration_policy" {
+				continue
+			}
+
+			_, err := tfsecurityhub.FindConfigurationPolicyByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Hub Configuration Policy (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCentralConfigurationConfig_base enables Security Hub central configuration with
+// the current account acting as the delegated administrator.
+const testAccCentralConfigurationConfig_base = `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_organization_admin_account" "test" {
+  admin_account_id = data.aws_caller_identity.current.account_id
+
+  depends_on = [aws_securityhub_account.test]
+}
+
+resource "aws_securityhub_finding_aggregator" "test" {
+  linking_mode = "ALL_REGIONS"
+
+  depends_on = [aws_securityhub_organization_admin_account.test]
+}
+
+resource "aws_securityhub_organization_configuration" "test" {
+  auto_enable           = false
+  auto_enable_standards = "NONE"
+
+  organization_configuration {
+    configuration_type = "CENTRAL"
+  }
+
+  depends_on = [aws_securityhub_finding_aggregator.test]
+}
+`
+
+func testAccConfigurationPolicyConfig_basic(rName, description string, serviceEnabled bool) string {
+	var standards string
+	if serviceEnabled {
+		standards = `enabled_standard_arns = ["arn:${data.aws_partition.current.partition}:securityhub:${data.aws_region.current.name}::standards/aws-foundational-security-best-practices/v/1.0.0"]
+
+    security_controls_configuration {
+      disabled_control_identifiers = []
+    }`
+	}
+
+	return acctest.ConfigCompose(testAccCentralConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_configuration_policy" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  configuration_policy {
+    service_enabled = %[3]t
+
+    %[4]s
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+`, rName, description, serviceEnabled, standards))
+}
+
+func testAccConfigurationPolicyConfig_controlIdentifiers(rName, attribute string) string {
+	return acctest.ConfigCompose(testAccCentralConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_configuration_policy" "test" {
+  name = %[1]q
+
+  configuration_policy {
+    service_enabled       = true
+    enabled_standard_arns = ["arn:${data.aws_partition.current.partition}:securityhub:${data.aws_region.current.name}::standards/aws-foundational-security-best-practices/v/1.0.0"]
+
+    security_controls_configuration {
+      %[2]s = ["APIGateway.1", "IAM.7"]
+    }
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+`, rName, attribute))
+}
+
+func testAccConfigurationPolicyConfig_customParameters(rName string, maxPasswordAge int) string {
+	return acctest.ConfigCompose(testAccCentralConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_configuration_policy" "test" {
+  name = %[1]q
+
+  configuration_policy {
+    service_enabled       = true
+    enabled_standard_arns = ["arn:${data.aws_partition.current.partition}:securityhub:${data.aws_region.current.name}::standards/aws-foundational-security-best-practices/v/1.0.0"]
+
+    security_controls_configuration {
+      disabled_control_identifiers = []
+
+      security_control_custom_parameter {
+        security_control_id = "IAM.7"
+
+        parameter {
+          name       = "MaxPasswordAge"
+          value_type = "CUSTOM"
+
+          int {
+            value = %[2]d
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+`, rName, maxPasswordAge))
+}
@@ -5,14 +5,18 @@ package securityhub
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // @SDKResource("aws_securityhub_organization_configuration")
@@ -27,6 +31,11 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"auto_enable": {
 				Type:     schema.TypeBool,
@@ -38,6 +47,21 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringInSlice(securityhub.AutoEnableStandards_Values(), false),
 			},
+			"organization_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configuration_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(securityhub.OrganizationConfigurationConfigurationType_Values(), false),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -54,6 +78,10 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		input.AutoEnableStandards = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("organization_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OrganizationConfiguration = expandOrganizationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.UpdateOrganizationConfigurationWithContext(ctx, input)
 
 	if err != nil {
@@ -64,6 +92,15 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		d.SetId(meta.(*conns.AWSClient).AccountID)
 	}
 
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if _, err := waitOrganizationConfigurationEnabled(ctx, conn, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Organization Configuration (%s) update: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceOrganizationConfigurationRead(ctx, d, meta)...)
 }
 
@@ -71,7 +108,7 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
 
-	output, err := conn.DescribeOrganizationConfigurationWithContext(ctx, &securityhub.DescribeOrganizationConfigurationInput{})
+	output, err := FindOrganizationConfiguration(ctx, conn)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Security Hub Organization Configuration: %s", err)
@@ -79,6 +116,95 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 
 	d.Set("auto_enable", output.AutoEnable)
 	d.Set("auto_enable_standards", output.AutoEnableStandards)
+	if err := d.Set("organization_configuration", flattenOrganizationConfiguration(output.OrganizationConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting organization_configuration: %s", err)
+	}
 
 	return diags
 }
+
+func FindOrganizationConfiguration(ctx context.Context, conn *securityhub.SecurityHub) (*securityhub.DescribeOrganizationConfigurationOutput, error) {
+	input := &securityhub.DescribeOrganizationConfigurationInput{}
+
+	output, err := conn.DescribeOrganizationConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusOrganizationConfiguration(ctx context.Context, conn *securityhub.SecurityHub) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOrganizationConfiguration(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Accounts that have not opted in to central configuration report no status.
+		if output.OrganizationConfiguration == nil || output.OrganizationConfiguration.Status == nil {
+			return output, securityhub.OrganizationConfigurationStatusEnabled, nil
+		}
+
+		return output, aws.StringValue(output.OrganizationConfiguration.Status), nil
+	}
+}
+
+func waitOrganizationConfigurationEnabled(ctx context.Context, conn *securityhub.SecurityHub, timeout time.Duration) (*securityhub.DescribeOrganizationConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{securityhub.OrganizationConfigurationStatusPending},
+		Target:     []string{securityhub.OrganizationConfigurationStatusEnabled},
+		Refresh:    statusOrganizationConfiguration(ctx, conn),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*securityhub.DescribeOrganizationConfigurationOutput); ok {
+		if output.OrganizationConfiguration != nil && aws.StringValue(output.OrganizationConfiguration.Status) == securityhub.OrganizationConfigurationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.OrganizationConfiguration.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandOrganizationConfiguration(tfMap map[string]interface{}) *securityhub.OrganizationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &securityhub.OrganizationConfiguration{}
+
+	if v, ok := tfMap["configuration_type"].(string); ok && v != "" {
+		apiObject.ConfigurationType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenOrganizationConfiguration(apiObject *securityhub.OrganizationConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"configuration_type": aws.StringValue(apiObject.ConfigurationType),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -20,6 +20,22 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"MigrateV0":                   testAccAccount_migrateV0,
 			"full":                        testAccAccount_full,
 		},
+		"AutomationRule": {
+			"basic":      testAccAutomationRule_basic,
+			"full":       testAccAutomationRule_full,
+			"disappears": testAccAutomationRule_disappears,
+			"tags":       testAccAutomationRule_tags,
+		},
+		"ConfigurationPolicy": {
+			"basic":              testAccConfigurationPolicy_basic,
+			"disappears":         testAccConfigurationPolicy_disappears,
+			"controlIdentifiers": testAccConfigurationPolicy_controlIdentifiers,
+			"customParameters":   testAccConfigurationPolicy_customParameters,
+		},
+		"ConfigurationPolicyAssociation": {
+			"basic":      testAccConfigurationPolicyAssociation_basic,
+			"disappears": testAccConfigurationPolicyAssociation_disappears,
+		},
 		"Member": {
 			"basic":  testAccMember_basic,
 			"invite": testAccMember_invite,
@@ -52,8 +68,9 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"MultiRegion": testAccOrganizationAdminAccount_MultiRegion,
 		},
 		"OrganizationConfiguration": {
-			"basic":               testAccOrganizationConfiguration_basic,
-			"AutoEnableStandards": testAccOrganizationConfiguration_autoEnableStandards,
+			"basic":                testAccOrganizationConfiguration_basic,
+			"AutoEnableStandards":  testAccOrganizationConfiguration_autoEnableStandards,
+			"CentralConfiguration": testAccOrganizationConfiguration_centralConfiguration,
 		},
 		"ProductSubscription": {
 			"basic": testAccProductSubscription_basic,
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_securitylake_aws_log_source", name="AWS Log Source")
+func resourceAWSLogSource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAWSLogSourceCreate,
+		ReadWithoutTimeout:   resourceAWSLogSourceRead,
+		DeleteWithoutTimeout: resourceAWSLogSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidRegionName,
+							},
+						},
+						"source_name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.AwsLogSourceName](),
+						},
+						"source_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAWSLogSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	tfMap := d.Get("source").([]interface{})[0].(map[string]interface{})
+	sourceName := tfMap["source_name"].(string)
+	input := &securitylake.CreateAwsLogSourceInput{
+		Sources: []types.AwsLogSourceConfiguration{expandAWSLogSourceConfiguration(tfMap)},
+	}
+
+	output, err := retryDataLakeConflict(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.CreateAwsLogSource(ctx, input)
+	})
+
+	if err == nil {
+		if v := output.(*securitylake.CreateAwsLogSourceOutput).Failed; len(v) > 0 {
+			err = fmt.Errorf("failed for accounts: %s", strings.Join(v, ", "))
+		}
+	}
+
+	if err != nil {
+		return diag.Errorf("creating Security Lake AWS Log Source (%s): %s", sourceName, err)
+	}
+
+	d.SetId(sourceName)
+
+	return resourceAWSLogSourceRead(ctx, d, meta)
+}
+
+func resourceAWSLogSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	logSource, err := findAWSLogSourceBySourceName(ctx, conn, types.AwsLogSourceName(d.Id()))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake AWS Log Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Lake AWS Log Source (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("source", []interface{}{flattenAWSLogSourceConfiguration(logSource)}); err != nil {
+		return diag.Errorf("setting source: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAWSLogSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	input := &securitylake.DeleteAwsLogSourceInput{
+		Sources: []types.AwsLogSourceConfiguration{expandAWSLogSourceConfiguration(d.Get("source").([]interface{})[0].(map[string]interface{}))},
+	}
+
+	log.Printf("[INFO] Deleting Security Lake AWS Log Source: %s", d.Id())
+	output, err := retryDataLakeConflict(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteAwsLogSource(ctx, input)
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err == nil {
+		if v := output.(*securitylake.DeleteAwsLogSourceOutput).Failed; len(v) > 0 {
+			err = fmt.Errorf("failed for accounts: %s", strings.Join(v, ", "))
+		}
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Lake AWS Log Source (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// findAWSLogSourceBySourceName returns the log source configuration for the specified
+// AWS log source, aggregating the accounts and Regions from which it is collected.
+func findAWSLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName types.AwsLogSourceName) (*types.AwsLogSourceConfiguration, error) {
+	input := &securitylake.ListLogSourcesInput{
+		Sources: []types.LogSourceResource{
+			&types.LogSourceResourceMemberAwsLogSource{
+				Value: types.AwsLogSourceResource{
+					SourceName: sourceName,
+				},
+			},
+		},
+	}
+	var output *types.AwsLogSourceConfiguration
+	accounts, regions := make(map[string]struct{}), make(map[string]struct{})
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Sources {
+			for _, v2 := range v.Sources {
+				v2, ok := v2.(*types.LogSourceResourceMemberAwsLogSource)
+
+				if !ok || v2.Value.SourceName != sourceName {
+					continue
+				}
+
+				if output == nil {
+					output = &types.AwsLogSourceConfiguration{
+						SourceName:    sourceName,
+						SourceVersion: v2.Value.SourceVersion,
+					}
+				}
+
+				accounts[aws.ToString(v.Account)] = struct{}{}
+				regions[aws.ToString(v.Region)] = struct{}{}
+			}
+		}
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{LastRequest: input}
+	}
+
+	for k := range accounts {
+		output.Accounts = append(output.Accounts, k)
+	}
+	for k := range regions {
+		output.Regions = append(output.Regions, k)
+	}
+
+	return output, nil
+}
+
+func expandAWSLogSourceConfiguration(tfMap map[string]interface{}) types.AwsLogSourceConfiguration {
+	apiObject := types.AwsLogSourceConfiguration{
+		SourceName: types.AwsLogSourceName(tfMap["source_name"].(string)),
+	}
+
+	if v, ok := tfMap["accounts"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Accounts = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Regions = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["source_version"].(string); ok && v != "" {
+		apiObject.SourceVersion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAWSLogSourceConfiguration(apiObject *types.AwsLogSourceConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"accounts":       apiObject.Accounts,
+		"regions":        apiObject.Regions,
+		"source_name":    string(apiObject.SourceName),
+		"source_version": aws.ToString(apiObject.SourceVersion),
+	}
+}
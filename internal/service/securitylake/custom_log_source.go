@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_securitylake_custom_log_source", name="Custom Log Source")
+func resourceCustomLogSource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomLogSourceCreate,
+		ReadWithoutTimeout:   resourceCustomLogSourceRead,
+		DeleteWithoutTimeout: resourceCustomLogSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"attributes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crawler_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"database_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"table_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crawler_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"provider_identity": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem:     awsIdentitySchema(true),
+						},
+					},
+				},
+			},
+			"event_classes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"provider_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"source_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+			"source_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 32),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9\-\.\_]*$`), "must contain only alphanumeric characters, hyphens, periods and underscores"),
+				),
+			},
+		},
+	}
+}
+
+func resourceCustomLogSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	sourceName := d.Get("source_name").(string)
+	input := &securitylake.CreateCustomLogSourceInput{
+		SourceName: aws.String(sourceName),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandCustomLogSourceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("event_classes"); ok && v.(*schema.Set).Len() > 0 {
+		input.EventClasses = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("source_version"); ok {
+		input.SourceVersion = aws.String(v.(string))
+	}
+
+	_, err := retryDataLakeConflict(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.CreateCustomLogSource(ctx, input)
+	})
+
+	if err != nil {
+		return diag.Errorf("creating Security Lake Custom Log Source (%s): %s", sourceName, err)
+	}
+
+	d.SetId(sourceName)
+
+	return resourceCustomLogSourceRead(ctx, d, meta)
+}
+
+func resourceCustomLogSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	customLogSource, err := findCustomLogSourceBySourceName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Custom Log Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Lake Custom Log Source (%s): %s", d.Id(), err)
+	}
+
+	if customLogSource.Attributes != nil {
+		if err := d.Set("attributes", []interface{}{flattenCustomLogSourceAttributes(customLogSource.Attributes)}); err != nil {
+			return diag.Errorf("setting attributes: %s", err)
+		}
+	} else {
+		d.Set("attributes", nil)
+	}
+	if customLogSource.Provider != nil {
+		if err := d.Set("provider_details", []interface{}{flattenCustomLogSourceProvider(customLogSource.Provider)}); err != nil {
+			return diag.Errorf("setting provider_details: %s", err)
+		}
+	} else {
+		d.Set("provider_details", nil)
+	}
+	d.Set("source_name", customLogSource.SourceName)
+	d.Set("source_version", customLogSource.SourceVersion)
+
+	return nil
+}
+
+func resourceCustomLogSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	log.Printf("[INFO] Deleting Security Lake Custom Log Source: %s", d.Id())
+	_, err := retryDataLakeConflict(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteCustomLogSource(ctx, &securitylake.DeleteCustomLogSourceInput{
+			SourceName:    aws.String(d.Id()),
+			SourceVersion: aws.String(d.Get("source_version").(string)),
+		})
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Lake Custom Log Source (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findCustomLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName string) (*types.CustomLogSourceResource, error) {
+	input := &securitylake.ListLogSourcesInput{
+		Sources: []types.LogSourceResource{
+			&types.LogSourceResourceMemberCustomLogSource{
+				Value: types.CustomLogSourceResource{
+					SourceName: aws.String(sourceName),
+				},
+			},
+		},
+	}
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Sources {
+			for _, v := range v.Sources {
+				if v, ok := v.(*types.LogSourceResourceMemberCustomLogSource); ok && aws.ToString(v.Value.SourceName) == sourceName {
+					return &v.Value, nil
+				}
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{LastRequest: input}
+}
+
+func awsIdentitySchema(forceNew bool) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"external_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: forceNew,
+			},
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: forceNew,
+			},
+		},
+	}
+}
+
+func expandCustomLogSourceConfiguration(tfMap map[string]interface{}) *types.CustomLogSourceConfiguration {
+	apiObject := &types.CustomLogSourceConfiguration{}
+
+	if v, ok := tfMap["crawler_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CrawlerConfiguration = &types.CustomLogSourceCrawlerConfiguration{}
+
+		if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+			apiObject.CrawlerConfiguration.RoleArn = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["provider_identity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ProviderIdentity = expandAWSIdentity(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAWSIdentity(tfMap map[string]interface{}) *types.AwsIdentity {
+	apiObject := &types.AwsIdentity{}
+
+	if v, ok := tfMap["external_id"].(string); ok && v != "" {
+		apiObject.ExternalId = aws.String(v)
+	}
+
+	if v, ok := tfMap["principal"].(string); ok && v != "" {
+		apiObject.Principal = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAWSIdentity(apiObject *types.AwsIdentity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"external_id": aws.ToString(apiObject.ExternalId),
+		"principal":   aws.ToString(apiObject.Principal),
+	}
+}
+
+func flattenCustomLogSourceAttributes(apiObject *types.CustomLogSourceAttributes) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"crawler_arn":  aws.ToString(apiObject.CrawlerArn),
+		"database_arn": aws.ToString(apiObject.DatabaseArn),
+		"table_arn":    aws.ToString(apiObject.TableArn),
+	}
+}
+
+func flattenCustomLogSourceProvider(apiObject *types.CustomLogSourceProvider) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"location": aws.ToString(apiObject.Location),
+		"role_arn": aws.ToString(apiObject.RoleArn),
+	}
+}
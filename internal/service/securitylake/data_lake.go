@@ -0,0 +1,592 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// s3ManagedKey is the value of `kms_key_id` that selects Amazon S3 managed encryption.
+	s3ManagedKey = "S3_MANAGED_KEY"
+)
+
+// @SDKResource("aws_securitylake_data_lake", name="Data Lake")
+// @Tags(identifierAttribute="arn")
+func resourceDataLake() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataLakeCreate,
+		ReadWithoutTimeout:   resourceDataLakeRead,
+		UpdateWithoutTimeout: resourceDataLakeUpdate,
+		DeleteWithoutTimeout: resourceDataLakeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  s3ManagedKey,
+									},
+								},
+							},
+						},
+						"lifecycle_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"days": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+									"transition": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"days": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"storage_class": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+						"replication_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"regions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidRegionName,
+										},
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"meta_store_manager_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"s3_bucket_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDataLakeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateDataLakeInput{
+		Configurations:          expandDataLakeConfigurations(d.Get("configuration").([]interface{})),
+		MetaStoreManagerRoleArn: aws.String(d.Get("meta_store_manager_role_arn").(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	output, err := retryDataLakeConflict(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.CreateDataLake(ctx, input)
+	})
+
+	if err != nil {
+		return diag.Errorf("creating Security Lake Data Lake: %s", err)
+	}
+
+	dataLakes := output.(*securitylake.CreateDataLakeOutput).DataLakes
+
+	if len(dataLakes) == 0 {
+		return diag.Errorf("creating Security Lake Data Lake: empty result")
+	}
+
+	d.SetId(aws.ToString(dataLakes[0].DataLakeArn))
+
+	if _, err := waitDataLakeCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Security Lake Data Lake (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDataLakeRead(ctx, d, meta)
+}
+
+func resourceDataLakeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	dataLake, err := findDataLakeByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Data Lake (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Lake Data Lake (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, dataLake.DataLakeArn)
+	if err := d.Set("configuration", []interface{}{flattenDataLakeResource(dataLake)}); err != nil {
+		return diag.Errorf("setting configuration: %s", err)
+	}
+	d.Set("s3_bucket_arn", dataLake.S3BucketArn)
+
+	return nil
+}
+
+func resourceDataLakeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	if d.HasChange("configuration") {
+		input := &securitylake.UpdateDataLakeInput{
+			Configurations: expandDataLakeConfigurations(d.Get("configuration").([]interface{})),
+		}
+
+		_, err := retryDataLakeConflict(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateDataLake(ctx, input)
+		})
+
+		if err != nil {
+			return diag.Errorf("updating Security Lake Data Lake (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDataLakeUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Security Lake Data Lake (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDataLakeRead(ctx, d, meta)
+}
+
+func resourceDataLakeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	region, err := regionFromARN(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Security Lake Data Lake: %s", d.Id())
+	_, err = retryDataLakeConflict(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteDataLake(ctx, &securitylake.DeleteDataLakeInput{
+			Regions: []string{region},
+		})
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Lake Data Lake (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDataLakeDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Security Lake Data Lake (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// retryDataLakeConflict retries the specified Security Lake operation while another
+// data lake or log source operation is in progress in the account.
+func retryDataLakeConflict(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return tfresource.RetryWhenIsA[*types.ConflictException](ctx, timeout, f)
+}
+
+func regionFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing ARN (%s): %w", s, err)
+	}
+
+	return v.Region, nil
+}
+
+func findDataLakeByARN(ctx context.Context, conn *securitylake.Client, arn string) (*types.DataLakeResource, error) {
+	region, err := regionFromARN(arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &securitylake.ListDataLakesInput{
+		Regions: []string{region},
+	}
+
+	output, err := conn.ListDataLakes(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.DataLakes {
+		if aws.ToString(v.DataLakeArn) == arn {
+			v := v
+
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{LastRequest: input}
+}
+
+func statusDataLakeCreate(ctx context.Context, conn *securitylake.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataLakeByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.CreateStatus), nil
+	}
+}
+
+func statusDataLakeUpdate(ctx context.Context, conn *securitylake.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataLakeByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.UpdateStatus == nil {
+			return output, string(types.DataLakeStatusCompleted), nil
+		}
+
+		return output, string(output.UpdateStatus.Status), nil
+	}
+}
+
+func waitDataLakeCreated(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*types.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.DataLakeStatusInitialized, types.DataLakeStatusPending),
+		Target:     enum.Slice(types.DataLakeStatusCompleted),
+		Refresh:    statusDataLakeCreate(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DataLakeResource); ok {
+		if v := output.UpdateStatus; v != nil && v.Exception != nil {
+			tfresource.SetLastError(err, dataLakeUpdateExceptionError(v.Exception))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeUpdated(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*types.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.DataLakeStatusInitialized, types.DataLakeStatusPending),
+		Target:     enum.Slice(types.DataLakeStatusCompleted),
+		Refresh:    statusDataLakeUpdate(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DataLakeResource); ok {
+		if v := output.UpdateStatus; v != nil && v.Exception != nil {
+			tfresource.SetLastError(err, dataLakeUpdateExceptionError(v.Exception))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeDeleted(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*types.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.DataLakeStatusInitialized, types.DataLakeStatusPending, types.DataLakeStatusCompleted),
+		Target:     []string{},
+		Refresh:    statusDataLakeCreate(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DataLakeResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func dataLakeUpdateExceptionError(apiObject *types.DataLakeUpdateException) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", aws.ToString(apiObject.Code), aws.ToString(apiObject.Reason))
+}
+
+func expandDataLakeConfigurations(tfList []interface{}) []types.DataLakeConfiguration {
+	var apiObjects []types.DataLakeConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.DataLakeConfiguration{}
+
+		if v, ok := tfMap["encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.EncryptionConfiguration = expandDataLakeEncryptionConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["lifecycle_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.LifecycleConfiguration = expandDataLakeLifecycleConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["region"].(string); ok && v != "" {
+			apiObject.Region = aws.String(v)
+		}
+
+		if v, ok := tfMap["replication_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ReplicationConfiguration = expandDataLakeReplicationConfiguration(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandDataLakeEncryptionConfiguration(tfMap map[string]interface{}) *types.DataLakeEncryptionConfiguration {
+	apiObject := &types.DataLakeEncryptionConfiguration{}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDataLakeLifecycleConfiguration(tfMap map[string]interface{}) *types.DataLakeLifecycleConfiguration {
+	apiObject := &types.DataLakeLifecycleConfiguration{}
+
+	if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		expiration := &types.DataLakeLifecycleExpiration{}
+
+		if v, ok := tfMap["days"].(int); ok && v > 0 {
+			expiration.Days = aws.Int32(int32(v))
+		}
+
+		apiObject.Expiration = expiration
+	}
+
+	if v, ok := tfMap["transition"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			transition := types.DataLakeLifecycleTransition{}
+
+			if v, ok := tfMap["days"].(int); ok {
+				transition.Days = aws.Int32(int32(v))
+			}
+
+			if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+				transition.StorageClass = aws.String(v)
+			}
+
+			apiObject.Transitions = append(apiObject.Transitions, transition)
+		}
+	}
+
+	return apiObject
+}
+
+func expandDataLakeReplicationConfiguration(tfMap map[string]interface{}) *types.DataLakeReplicationConfiguration {
+	apiObject := &types.DataLakeReplicationConfiguration{}
+
+	if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Regions = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataLakeResource(apiObject *types.DataLakeResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"region": aws.ToString(apiObject.Region),
+	}
+
+	if v := apiObject.EncryptionConfiguration; v != nil {
+		tfMap["encryption_configuration"] = []interface{}{map[string]interface{}{
+			"kms_key_id": aws.ToString(v.KmsKeyId),
+		}}
+	}
+
+	if v := apiObject.LifecycleConfiguration; v != nil && (v.Expiration != nil || len(v.Transitions) > 0) {
+		tfMap["lifecycle_configuration"] = []interface{}{flattenDataLakeLifecycleConfiguration(v)}
+	}
+
+	if v := apiObject.ReplicationConfiguration; v != nil && (len(v.Regions) > 0 || v.RoleArn != nil) {
+		tfMap["replication_configuration"] = []interface{}{map[string]interface{}{
+			"regions":  v.Regions,
+			"role_arn": aws.ToString(v.RoleArn),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenDataLakeLifecycleConfiguration(apiObject *types.DataLakeLifecycleConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Expiration; v != nil {
+		tfMap["expiration"] = []interface{}{map[string]interface{}{
+			"days": aws.ToInt32(v.Days),
+		}}
+	}
+
+	if v := apiObject.Transitions; len(v) > 0 {
+		tfList := make([]interface{}, 0, len(v))
+
+		for _, v := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"days":          aws.ToInt32(v.Days),
+				"storage_class": aws.ToString(v.StorageClass),
+			})
+		}
+
+		tfMap["transition"] = tfList
+	}
+
+	return tfMap
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+// Exports for use in tests only.
+var (
+	FindAWSLogSourceBySourceName             = findAWSLogSourceBySourceName
+	FindCustomLogSourceBySourceName          = findCustomLogSourceBySourceName
+	FindDataLakeByARN                        = findDataLakeByARN
+	FindSubscriberByID                       = findSubscriberByID
+	FindSubscriberNotificationBySubscriberID = findSubscriberNotificationBySubscriberID
+
+	ResourceAWSLogSource           = resourceAWSLogSource
+	ResourceCustomLogSource        = resourceCustomLogSource
+	ResourceDataLake               = resourceDataLake
+	ResourceSubscriber             = resourceSubscriber
+	ResourceSubscriberNotification = resourceSubscriberNotification
+)
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Security Lake is enabled once per account and Region, so all of its acceptance tests must run serially.
+func TestAccSecurityLake_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"AWSLogSource": {
+			"basic":      testAccAWSLogSource_basic,
+			"disappears": testAccAWSLogSource_disappears,
+			"multiple":   testAccAWSLogSource_multiple,
+		},
+		"CustomLogSource": {
+			"basic":      testAccCustomLogSource_basic,
+			"disappears": testAccCustomLogSource_disappears,
+		},
+		"DataLake": {
+			"basic":       testAccDataLake_basic,
+			"disappears":  testAccDataLake_disappears,
+			"lifeCycle":   testAccDataLake_lifeCycle,
+			"replication": testAccDataLake_replication,
+			"tags":        testAccDataLake_tags,
+		},
+		"Subscriber": {
+			"basic":      testAccSubscriber_basic,
+			"disappears": testAccSubscriber_disappears,
+			"tags":       testAccSubscriber_tags,
+			"update":     testAccSubscriber_update,
+		},
+		"SubscriberNotification": {
+			"https":      testAccSubscriberNotification_https,
+			"sqs":        testAccSubscriberNotification_sqs,
+			"disappears": testAccSubscriberNotification_disappears,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
@@ -27,7 +27,39 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceAWSLogSource,
+			TypeName: "aws_securitylake_aws_log_source",
+			Name:     "AWS Log Source",
+		},
+		{
+			Factory:  resourceCustomLogSource,
+			TypeName: "aws_securitylake_custom_log_source",
+			Name:     "Custom Log Source",
+		},
+		{
+			Factory:  resourceDataLake,
+			TypeName: "aws_securitylake_data_lake",
+			Name:     "Data Lake",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  resourceSubscriber,
+			TypeName: "aws_securitylake_subscriber",
+			Name:     "Subscriber",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  resourceSubscriberNotification,
+			TypeName: "aws_securitylake_subscriber_notification",
+			Name:     "Subscriber Notification",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
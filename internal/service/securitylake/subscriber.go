@@ -0,0 +1,449 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_securitylake_subscriber", name="Subscriber")
+// @Tags(identifierAttribute="arn")
+func resourceSubscriber() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSubscriberCreate,
+		ReadWithoutTimeout:   resourceSubscriberRead,
+		UpdateWithoutTimeout: resourceSubscriberUpdate,
+		DeleteWithoutTimeout: resourceSubscriberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.AccessType](),
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_share_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_share_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_bucket_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_log_source_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_name": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AwsLogSourceName](),
+									},
+									"source_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"custom_log_source_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"source_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"subscriber_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subscriber_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscriber_identity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     awsIdentitySchema(false),
+			},
+			"subscriber_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"subscriber_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSubscriberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	name := d.Get("subscriber_name").(string)
+	input := &securitylake.CreateSubscriberInput{
+		Sources:            expandLogSourceResources(d.Get("source").(*schema.Set).List()),
+		SubscriberIdentity: expandAWSIdentity(d.Get("subscriber_identity").([]interface{})[0].(map[string]interface{})),
+		SubscriberName:     aws.String(name),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("access_type"); ok {
+		input.AccessTypes = []types.AccessType{types.AccessType(v.(string))}
+	}
+
+	if v, ok := d.GetOk("subscriber_description"); ok {
+		input.SubscriberDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateSubscriber(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Security Lake Subscriber (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Subscriber.SubscriberId))
+
+	if _, err := waitSubscriberCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Security Lake Subscriber (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceSubscriberRead(ctx, d, meta)
+}
+
+func resourceSubscriberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	subscriber, err := findSubscriberByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Subscriber (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Lake Subscriber (%s): %s", d.Id(), err)
+	}
+
+	if len(subscriber.AccessTypes) > 0 {
+		d.Set("access_type", subscriber.AccessTypes[0])
+	} else {
+		d.Set("access_type", nil)
+	}
+	d.Set(names.AttrARN, subscriber.SubscriberArn)
+	d.Set("resource_share_arn", subscriber.ResourceShareArn)
+	d.Set("resource_share_name", subscriber.ResourceShareName)
+	d.Set("role_arn", subscriber.RoleArn)
+	d.Set("s3_bucket_arn", subscriber.S3BucketArn)
+	if err := d.Set("source", flattenLogSourceResources(subscriber.Sources)); err != nil {
+		return diag.Errorf("setting source: %s", err)
+	}
+	d.Set("subscriber_description", subscriber.SubscriberDescription)
+	d.Set("subscriber_endpoint", subscriber.SubscriberEndpoint)
+	if subscriber.SubscriberIdentity != nil {
+		if err := d.Set("subscriber_identity", []interface{}{flattenAWSIdentity(subscriber.SubscriberIdentity)}); err != nil {
+			return diag.Errorf("setting subscriber_identity: %s", err)
+		}
+	} else {
+		d.Set("subscriber_identity", nil)
+	}
+	d.Set("subscriber_name", subscriber.SubscriberName)
+	d.Set("subscriber_status", subscriber.SubscriberStatus)
+
+	return nil
+}
+
+func resourceSubscriberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &securitylake.UpdateSubscriberInput{
+			SubscriberId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("source") {
+			input.Sources = expandLogSourceResources(d.Get("source").(*schema.Set).List())
+		}
+
+		if d.HasChange("subscriber_description") {
+			input.SubscriberDescription = aws.String(d.Get("subscriber_description").(string))
+		}
+
+		if d.HasChange("subscriber_identity") {
+			input.SubscriberIdentity = expandAWSIdentity(d.Get("subscriber_identity").([]interface{})[0].(map[string]interface{}))
+		}
+
+		if d.HasChange("subscriber_name") {
+			input.SubscriberName = aws.String(d.Get("subscriber_name").(string))
+		}
+
+		_, err := conn.UpdateSubscriber(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Security Lake Subscriber (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitSubscriberUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Security Lake Subscriber (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSubscriberRead(ctx, d, meta)
+}
+
+func resourceSubscriberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	log.Printf("[INFO] Deleting Security Lake Subscriber: %s", d.Id())
+	_, err := conn.DeleteSubscriber(ctx, &securitylake.DeleteSubscriberInput{
+		SubscriberId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Lake Subscriber (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSubscriberDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Security Lake Subscriber (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findSubscriberByID(ctx context.Context, conn *securitylake.Client, id string) (*types.SubscriberResource, error) {
+	input := &securitylake.GetSubscriberInput{
+		SubscriberId: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriber(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscriber == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscriber, nil
+}
+
+func statusSubscriber(ctx context.Context, conn *securitylake.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSubscriberByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.SubscriberStatus), nil
+	}
+}
+
+func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*types.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.SubscriberStatusPending),
+		Target:     enum.Slice(types.SubscriberStatusActive, types.SubscriberStatusReady),
+		Refresh:    statusSubscriber(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberUpdated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*types.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.SubscriberStatusPending),
+		Target:                    enum.Slice(types.SubscriberStatusActive, types.SubscriberStatusReady),
+		Refresh:                   statusSubscriber(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberDeleted(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*types.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.SubscriberStatusActive, types.SubscriberStatusDeactivated, types.SubscriberStatusPending, types.SubscriberStatusReady),
+		Target:     []string{},
+		Refresh:    statusSubscriber(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandLogSourceResources(tfList []interface{}) []types.LogSourceResource {
+	var apiObjects []types.LogSourceResource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["aws_log_source_resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject := &types.LogSourceResourceMemberAwsLogSource{
+				Value: types.AwsLogSourceResource{
+					SourceName: types.AwsLogSourceName(tfMap["source_name"].(string)),
+				},
+			}
+
+			if v, ok := tfMap["source_version"].(string); ok && v != "" {
+				apiObject.Value.SourceVersion = aws.String(v)
+			}
+
+			apiObjects = append(apiObjects, apiObject)
+		}
+
+		if v, ok := tfMap["custom_log_source_resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject := &types.LogSourceResourceMemberCustomLogSource{
+				Value: types.CustomLogSourceResource{
+					SourceName: aws.String(tfMap["source_name"].(string)),
+				},
+			}
+
+			if v, ok := tfMap["source_version"].(string); ok && v != "" {
+				apiObject.Value.SourceVersion = aws.String(v)
+			}
+
+			apiObjects = append(apiObjects, apiObject)
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenLogSourceResources(apiObjects []types.LogSourceResource) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		switch v := apiObject.(type) {
+		case *types.LogSourceResourceMemberAwsLogSource:
+			tfList = append(tfList, map[string]interface{}{
+				"aws_log_source_resource": []interface{}{map[string]interface{}{
+					"source_name":    string(v.Value.SourceName),
+					"source_version": aws.ToString(v.Value.SourceVersion),
+				}},
+			})
+		case *types.LogSourceResourceMemberCustomLogSource:
+			tfList = append(tfList, map[string]interface{}{
+				"custom_log_source_resource": []interface{}{map[string]interface{}{
+					"source_name":    aws.ToString(v.Value.SourceName),
+					"source_version": aws.ToString(v.Value.SourceVersion),
+				}},
+			})
+		}
+	}
+
+	return tfList
+}
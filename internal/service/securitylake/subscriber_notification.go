@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_securitylake_subscriber_notification", name="Subscriber Notification")
+func resourceSubscriberNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSubscriberNotificationCreate,
+		ReadWithoutTimeout:   resourceSubscriberNotificationRead,
+		UpdateWithoutTimeout: resourceSubscriberNotificationUpdate,
+		DeleteWithoutTimeout: resourceSubscriberNotificationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"https_notification_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.https_notification_configuration", "configuration.0.sqs_notification_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authorization_api_key_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"authorization_api_key_value": {
+										Type:      schema.TypeString,
+										Optional:  true,
+										Sensitive: true,
+									},
+									"endpoint": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"http_method": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.HttpMethod](),
+									},
+									"target_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"sqs_notification_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.https_notification_configuration", "configuration.0.sqs_notification_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+					},
+				},
+			},
+			"endpoint_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscriber_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSubscriberNotificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	subscriberID := d.Get("subscriber_id").(string)
+	input := &securitylake.CreateSubscriberNotificationInput{
+		Configuration: expandNotificationConfiguration(d.Get("configuration").([]interface{})),
+		SubscriberId:  aws.String(subscriberID),
+	}
+
+	_, err := conn.CreateSubscriberNotification(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Security Lake Subscriber Notification (%s): %s", subscriberID, err)
+	}
+
+	d.SetId(subscriberID)
+
+	return resourceSubscriberNotificationRead(ctx, d, meta)
+}
+
+func resourceSubscriberNotificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	subscriber, err := findSubscriberNotificationBySubscriberID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Subscriber Notification (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Lake Subscriber Notification (%s): %s", d.Id(), err)
+	}
+
+	d.Set("endpoint_id", subscriber.SubscriberEndpoint)
+	d.Set("subscriber_id", subscriber.SubscriberId)
+
+	return nil
+}
+
+func resourceSubscriberNotificationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	input := &securitylake.UpdateSubscriberNotificationInput{
+		Configuration: expandNotificationConfiguration(d.Get("configuration").([]interface{})),
+		SubscriberId:  aws.String(d.Id()),
+	}
+
+	_, err := conn.UpdateSubscriberNotification(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Security Lake Subscriber Notification (%s): %s", d.Id(), err)
+	}
+
+	return resourceSubscriberNotificationRead(ctx, d, meta)
+}
+
+func resourceSubscriberNotificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	log.Printf("[INFO] Deleting Security Lake Subscriber Notification: %s", d.Id())
+	_, err := conn.DeleteSubscriberNotification(ctx, &securitylake.DeleteSubscriberNotificationInput{
+		SubscriberId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Lake Subscriber Notification (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// findSubscriberNotificationBySubscriberID returns the subscriber if it has a notification endpoint configured.
+func findSubscriberNotificationBySubscriberID(ctx context.Context, conn *securitylake.Client, id string) (*types.SubscriberResource, error) {
+	output, err := findSubscriberByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.ToString(output.SubscriberEndpoint) == "" {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return output, nil
+}
+
+func expandNotificationConfiguration(tfList []interface{}) types.NotificationConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["https_notification_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject := &types.NotificationConfigurationMemberHttpsNotificationConfiguration{}
+
+		if v, ok := tfMap["authorization_api_key_name"].(string); ok && v != "" {
+			apiObject.Value.AuthorizationApiKeyName = aws.String(v)
+		}
+
+		if v, ok := tfMap["authorization_api_key_value"].(string); ok && v != "" {
+			apiObject.Value.AuthorizationApiKeyValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["endpoint"].(string); ok && v != "" {
+			apiObject.Value.Endpoint = aws.String(v)
+		}
+
+		if v, ok := tfMap["http_method"].(string); ok && v != "" {
+			apiObject.Value.HttpMethod = types.HttpMethod(v)
+		}
+
+		if v, ok := tfMap["target_role_arn"].(string); ok && v != "" {
+			apiObject.Value.TargetRoleArn = aws.String(v)
+		}
+
+		return apiObject
+	}
+
+	if _, ok := tfMap["sqs_notification_configuration"].([]interface{}); ok {
+		return &types.NotificationConfigurationMemberSqsNotificationConfiguration{}
+	}
+
+	return nil
+}
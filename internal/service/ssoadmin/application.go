@@ -0,0 +1,419 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Application")
+// @Tags
+func newResourceApplication(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceApplication{}, nil
+}
+
+type resourceApplication struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceApplication) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_application"
+}
+
+func (r *resourceApplication) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_account": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_arn": framework.ARNAttributeComputedOnly(),
+			"application_provider_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"instance_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"status": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ssoadmin.ApplicationStatus_Values()...),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"portal_options": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"visibility": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								stringvalidator.OneOf(ssoadmin.ApplicationVisibility_Values()...),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"sign_in_options": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"application_url": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+										},
+									},
+									"origin": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(ssoadmin.SignInOrigin_Values()...),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceApplication) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceApplicationData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	input := &ssoadmin.CreateApplicationInput{
+		ApplicationProviderArn: flex.StringFromFramework(ctx, data.ApplicationProviderARN),
+		Description:            flex.StringFromFramework(ctx, data.Description),
+		InstanceArn:            flex.StringFromFramework(ctx, data.InstanceARN),
+		Name:                   flex.StringFromFramework(ctx, data.Name),
+		PortalOptions:          flex.ExpandFrameworkListNestedBlockPtr(ctx, data.PortalOptions, r.expandPortalOptions),
+		Status:                 flex.StringFromFramework(ctx, data.Status),
+		Tags:                   getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateApplicationWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSO Application (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	arn := aws.StringValue(output.ApplicationArn)
+	data.ApplicationARN = types.StringValue(arn)
+	data.ID = types.StringValue(arn)
+
+	// Set values for unknowns.
+	application, err := FindApplicationByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application (%s)", arn), err.Error())
+
+		return
+	}
+
+	data.ApplicationAccount = flex.StringToFramework(ctx, application.ApplicationAccount)
+	data.PortalOptions = r.flattenPortalOptions(ctx, application.PortalOptions)
+	data.Status = flex.StringToFramework(ctx, application.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApplication) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceApplicationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	output, err := FindApplicationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ApplicationAccount = flex.StringToFramework(ctx, output.ApplicationAccount)
+	data.ApplicationARN = flex.StringToFramework(ctx, output.ApplicationArn)
+	data.ApplicationProviderARN = flex.StringToFramework(ctx, output.ApplicationProviderArn)
+	data.Description = flex.StringToFramework(ctx, output.Description)
+	data.InstanceARN = flex.StringToFramework(ctx, output.InstanceArn)
+	data.Name = flex.StringToFramework(ctx, output.Name)
+	data.PortalOptions = r.flattenPortalOptions(ctx, output.PortalOptions)
+	data.Status = flex.StringToFramework(ctx, output.Status)
+
+	// Tags are scoped to the Identity Center instance.
+	tags, err := listTags(ctx, conn, data.ID.ValueString(), data.InstanceARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for SSO Application (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApplication) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceApplicationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.Name.Equal(old.Name) || !new.PortalOptions.Equal(old.PortalOptions) || !new.Status.Equal(old.Status) {
+		input := &ssoadmin.UpdateApplicationInput{
+			ApplicationArn: flex.StringFromFramework(ctx, new.ID),
+			Description:    flex.StringFromFramework(ctx, new.Description),
+			Name:           flex.StringFromFramework(ctx, new.Name),
+			Status:         flex.StringFromFramework(ctx, new.Status),
+		}
+
+		if v := flex.ExpandFrameworkListNestedBlockPtr(ctx, new.PortalOptions, r.expandPortalOptions); v != nil {
+			input.PortalOptions = &ssoadmin.UpdateApplicationPortalOptions{
+				SignInOptions: v.SignInOptions,
+			}
+		}
+
+		_, err := conn.UpdateApplicationWithContext(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SSO Application (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.TagsAll.Equal(old.TagsAll) {
+		if err := updateTags(ctx, conn, new.ID.ValueString(), new.InstanceARN.ValueString(), old.TagsAll, new.TagsAll); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating tags for SSO Application (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceApplication) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceApplicationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	tflog.Debug(ctx, "deleting SSO Application", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteApplicationWithContext(ctx, &ssoadmin.DeleteApplicationInput{
+		ApplicationArn: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSO Application (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceApplication) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (r *resourceApplication) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func (r *resourceApplication) expandPortalOptions(ctx context.Context, data applicationPortalOptionsData) *ssoadmin.PortalOptions {
+	return &ssoadmin.PortalOptions{
+		SignInOptions: flex.ExpandFrameworkListNestedBlockPtr(ctx, data.SignInOptions, r.expandSignInOptions),
+		Visibility:    flex.StringFromFramework(ctx, data.Visibility),
+	}
+}
+
+func (r *resourceApplication) expandSignInOptions(ctx context.Context, data applicationSignInOptionsData) *ssoadmin.SignInOptions {
+	return &ssoadmin.SignInOptions{
+		ApplicationUrl: flex.StringFromFramework(ctx, data.ApplicationURL),
+		Origin:         flex.StringFromFramework(ctx, data.Origin),
+	}
+}
+
+func (r *resourceApplication) flattenPortalOptions(ctx context.Context, apiObject *ssoadmin.PortalOptions) types.List {
+	attributeTypes := flex.AttributeTypesMust[applicationPortalOptionsData](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"sign_in_options": r.flattenSignInOptions(ctx, apiObject.SignInOptions),
+			"visibility":      flex.StringToFramework(ctx, apiObject.Visibility),
+		}),
+	})
+}
+
+func (r *resourceApplication) flattenSignInOptions(ctx context.Context, apiObject *ssoadmin.SignInOptions) types.List {
+	attributeTypes := flex.AttributeTypesMust[applicationSignInOptionsData](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"application_url": flex.StringToFramework(ctx, apiObject.ApplicationUrl),
+			"origin":          flex.StringToFramework(ctx, apiObject.Origin),
+		}),
+	})
+}
+
+// See https://docs.aws.amazon.com/singlesignon/latest/APIReference/API_DescribeApplication.html.
+type resourceApplicationData struct {
+	ApplicationAccount     types.String `tfsdk:"application_account"`
+	ApplicationARN         types.String `tfsdk:"application_arn"`
+	ApplicationProviderARN types.String `tfsdk:"application_provider_arn"`
+	Description            types.String `tfsdk:"description"`
+	ID                     types.String `tfsdk:"id"`
+	InstanceARN            types.String `tfsdk:"instance_arn"`
+	Name                   types.String `tfsdk:"name"`
+	PortalOptions          types.List   `tfsdk:"portal_options"`
+	Status                 types.String `tfsdk:"status"`
+	Tags                   types.Map    `tfsdk:"tags"`
+	TagsAll                types.Map    `tfsdk:"tags_all"`
+}
+
+type applicationPortalOptionsData struct {
+	SignInOptions types.List   `tfsdk:"sign_in_options"`
+	Visibility    types.String `tfsdk:"visibility"`
+}
+
+type applicationSignInOptionsData struct {
+	ApplicationURL types.String `tfsdk:"application_url"`
+	Origin         types.String `tfsdk:"origin"`
+}
+
+func FindApplicationByARN(ctx context.Context, conn *ssoadmin.SSOAdmin, arn string) (*ssoadmin.DescribeApplicationOutput, error) {
+	input := &ssoadmin.DescribeApplicationInput{
+		ApplicationArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeApplicationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
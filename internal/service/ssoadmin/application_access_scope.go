@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource(name="Application Access Scope")
+func newResourceApplicationAccessScope(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceApplicationAccessScope{}, nil
+}
+
+const (
+	applicationAccessScopeIDPartCount = 2
+)
+
+type resourceApplicationAccessScope struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceApplicationAccessScope) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_application_access_scope"
+}
+
+func (r *resourceApplicationAccessScope) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authorized_targets": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"scope": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceApplicationAccessScope) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceApplicationAccessScopeData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	id, err := intflex.FlattenResourceId([]string{data.ApplicationARN.ValueString(), data.Scope.ValueString()}, applicationAccessScopeIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating SSO Application Access Scope", err.Error())
+
+		return
+	}
+
+	input := &ssoadmin.PutApplicationAccessScopeInput{
+		ApplicationArn:    flex.StringFromFramework(ctx, data.ApplicationARN),
+		AuthorizedTargets: flex.ExpandFrameworkStringList(ctx, data.AuthorizedTargets),
+		Scope:             flex.StringFromFramework(ctx, data.Scope),
+	}
+
+	_, err = conn.PutApplicationAccessScopeWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSO Application Access Scope (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApplicationAccessScope) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceApplicationAccessScopeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), applicationAccessScopeIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing SSO Application Access Scope ID", err.Error())
+
+		return
+	}
+
+	output, err := FindApplicationAccessScopeByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application Access Scope (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ApplicationARN = types.StringValue(parts[0])
+	data.AuthorizedTargets = flex.FlattenFrameworkStringList(ctx, output.AuthorizedTargets)
+	data.Scope = flex.StringToFramework(ctx, output.Scope)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApplicationAccessScope) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// All attributes force replacement.
+}
+
+func (r *resourceApplicationAccessScope) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceApplicationAccessScopeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	tflog.Debug(ctx, "deleting SSO Application Access Scope", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteApplicationAccessScopeWithContext(ctx, &ssoadmin.DeleteApplicationAccessScopeInput{
+		ApplicationArn: flex.StringFromFramework(ctx, data.ApplicationARN),
+		Scope:          flex.StringFromFramework(ctx, data.Scope),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSO Application Access Scope (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceApplicationAccessScope) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+type resourceApplicationAccessScopeData struct {
+	ApplicationARN    types.String `tfsdk:"application_arn"`
+	AuthorizedTargets types.List   `tfsdk:"authorized_targets"`
+	ID                types.String `tfsdk:"id"`
+	Scope             types.String `tfsdk:"scope"`
+}
+
+func FindApplicationAccessScopeByTwoPartKey(ctx context.Context, conn *ssoadmin.SSOAdmin, applicationARN, scope string) (*ssoadmin.GetApplicationAccessScopeOutput, error) {
+	input := &ssoadmin.GetApplicationAccessScopeInput{
+		ApplicationArn: aws.String(applicationARN),
+		Scope:          aws.String(scope),
+	}
+
+	output, err := conn.GetApplicationAccessScopeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource(name="Application Assignment")
+func newResourceApplicationAssignment(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceApplicationAssignment{}, nil
+}
+
+const (
+	applicationAssignmentIDPartCount = 3
+)
+
+type resourceApplicationAssignment struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceApplicationAssignment) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_application_assignment"
+}
+
+func (r *resourceApplicationAssignment) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"principal_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ssoadmin.PrincipalType_Values()...),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceApplicationAssignment) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceApplicationAssignmentData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	id, err := intflex.FlattenResourceId([]string{data.ApplicationARN.ValueString(), data.PrincipalID.ValueString(), data.PrincipalType.ValueString()}, applicationAssignmentIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating SSO Application Assignment", err.Error())
+
+		return
+	}
+
+	input := &ssoadmin.CreateApplicationAssignmentInput{
+		ApplicationArn: flex.StringFromFramework(ctx, data.ApplicationARN),
+		PrincipalId:    flex.StringFromFramework(ctx, data.PrincipalID),
+		PrincipalType:  flex.StringFromFramework(ctx, data.PrincipalType),
+	}
+
+	_, err = conn.CreateApplicationAssignmentWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSO Application Assignment (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApplicationAssignment) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceApplicationAssignmentData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), applicationAssignmentIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing SSO Application Assignment ID", err.Error())
+
+		return
+	}
+
+	output, err := FindApplicationAssignmentByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application Assignment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ApplicationARN = flex.StringToFramework(ctx, output.ApplicationArn)
+	data.PrincipalID = flex.StringToFramework(ctx, output.PrincipalId)
+	data.PrincipalType = flex.StringToFramework(ctx, output.PrincipalType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApplicationAssignment) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// All attributes force replacement.
+}
+
+func (r *resourceApplicationAssignment) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceApplicationAssignmentData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	tflog.Debug(ctx, "deleting SSO Application Assignment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteApplicationAssignmentWithContext(ctx, &ssoadmin.DeleteApplicationAssignmentInput{
+		ApplicationArn: flex.StringFromFramework(ctx, data.ApplicationARN),
+		PrincipalId:    flex.StringFromFramework(ctx, data.PrincipalID),
+		PrincipalType:  flex.StringFromFramework(ctx, data.PrincipalType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSO Application Assignment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceApplicationAssignment) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+type resourceApplicationAssignmentData struct {
+	ApplicationARN types.String `tfsdk:"application_arn"`
+	ID             types.String `tfsdk:"id"`
+	PrincipalID    types.String `tfsdk:"principal_id"`
+	PrincipalType  types.String `tfsdk:"principal_type"`
+}
+
+func FindApplicationAssignmentByThreePartKey(ctx context.Context, conn *ssoadmin.SSOAdmin, applicationARN, principalID, principalType string) (*ssoadmin.DescribeApplicationAssignmentOutput, error) {
+	input := &ssoadmin.DescribeApplicationAssignmentInput{
+		ApplicationArn: aws.String(applicationARN),
+		PrincipalId:    aws.String(principalID),
+		PrincipalType:  aws.String(principalType),
+	}
+
+	output, err := conn.DescribeApplicationAssignmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
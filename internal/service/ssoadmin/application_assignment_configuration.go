@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource(name="Application Assignment Configuration")
+func newResourceApplicationAssignmentConfiguration(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceApplicationAssignmentConfiguration{}, nil
+}
+
+type resourceApplicationAssignmentConfiguration struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_application_assignment_configuration"
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"assignment_required": schema.BoolAttribute{
+				Required: true,
+			},
+			"id": framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceApplicationAssignmentConfigurationData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	input := &ssoadmin.PutApplicationAssignmentConfigurationInput{
+		ApplicationArn:     flex.StringFromFramework(ctx, data.ApplicationARN),
+		AssignmentRequired: flex.BoolFromFramework(ctx, data.AssignmentRequired),
+	}
+
+	_, err := conn.PutApplicationAssignmentConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSO Application Assignment Configuration (%s)", data.ApplicationARN.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = data.ApplicationARN
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceApplicationAssignmentConfigurationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	output, err := FindApplicationAssignmentConfigurationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application Assignment Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ApplicationARN = data.ID
+	data.AssignmentRequired = flex.BoolToFramework(ctx, output.AssignmentRequired)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceApplicationAssignmentConfigurationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	if !new.AssignmentRequired.Equal(old.AssignmentRequired) {
+		input := &ssoadmin.PutApplicationAssignmentConfigurationInput{
+			ApplicationArn:     flex.StringFromFramework(ctx, new.ID),
+			AssignmentRequired: flex.BoolFromFramework(ctx, new.AssignmentRequired),
+		}
+
+		_, err := conn.PutApplicationAssignmentConfigurationWithContext(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SSO Application Assignment Configuration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// Delete restores the default configuration, in which all users and groups must be
+// assigned to the application before they can access it.
+func (r *resourceApplicationAssignmentConfiguration) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceApplicationAssignmentConfigurationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	tflog.Debug(ctx, "deleting SSO Application Assignment Configuration", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.PutApplicationAssignmentConfigurationWithContext(ctx, &ssoadmin.PutApplicationAssignmentConfigurationInput{
+		ApplicationArn:     flex.StringFromFramework(ctx, data.ID),
+		AssignmentRequired: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSO Application Assignment Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceApplicationAssignmentConfiguration) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+type resourceApplicationAssignmentConfigurationData struct {
+	ApplicationARN     types.String `tfsdk:"application_arn"`
+	AssignmentRequired types.Bool   `tfsdk:"assignment_required"`
+	ID                 types.String `tfsdk:"id"`
+}
+
+func FindApplicationAssignmentConfigurationByARN(ctx context.Context, conn *ssoadmin.SSOAdmin, arn string) (*ssoadmin.GetApplicationAssignmentConfigurationOutput, error) {
+	input := &ssoadmin.GetApplicationAssignmentConfigurationInput{
+		ApplicationArn: aws.String(arn),
+	}
+
+	output, err := conn.GetApplicationAssignmentConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkDataSource(name="Application Provider")
+func newDataSourceApplicationProvider(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceApplicationProvider{}, nil
+}
+
+type dataSourceApplicationProvider struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceApplicationProvider) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_application_provider"
+}
+
+func (d *dataSourceApplicationProvider) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_provider_arn": schema.StringAttribute{
+				Required: true,
+			},
+			"display_data": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: applicationProviderDisplayDataAttrTypes},
+				Computed:    true,
+			},
+			"federation_protocol": schema.StringAttribute{
+				Computed: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceApplicationProvider) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceApplicationProviderData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SSOAdminConn(ctx)
+
+	output, err := findApplicationProviderByARN(ctx, conn, data.ApplicationProviderARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application Provider (%s)", data.ApplicationProviderARN.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ApplicationProviderARN = flex.StringToFramework(ctx, output.ApplicationProviderArn)
+	data.DisplayData = flattenApplicationProviderDisplayData(ctx, output.DisplayData)
+	data.FederationProtocol = flex.StringToFramework(ctx, output.FederationProtocol)
+	data.ID = flex.StringToFramework(ctx, output.ApplicationProviderArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceApplicationProviderData struct {
+	ApplicationProviderARN types.String `tfsdk:"application_provider_arn"`
+	DisplayData            types.List   `tfsdk:"display_data"`
+	FederationProtocol     types.String `tfsdk:"federation_protocol"`
+	ID                     types.String `tfsdk:"id"`
+}
+
+func findApplicationProviderByARN(ctx context.Context, conn *ssoadmin.SSOAdmin, arn string) (*ssoadmin.DescribeApplicationProviderOutput, error) {
+	input := &ssoadmin.DescribeApplicationProviderInput{
+		ApplicationProviderArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeApplicationProviderWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
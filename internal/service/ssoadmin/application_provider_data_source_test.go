@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSSOAdminApplicationProviderDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssoadmin_application_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationProviderDataSourceConfig_basic(testAccApplicationProviderARN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "application_provider_arn", testAccApplicationProviderARN),
+					resource.TestCheckResourceAttr(dataSourceName, "display_data.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "display_data.0.display_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "federation_protocol"),
+					resource.TestCheckResourceAttr(dataSourceName, "id", testAccApplicationProviderARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationProviderDataSourceConfig_basic(arn string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_application_provider" "test" {
+  application_provider_arn = %[1]q
+}
+`, arn)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+var (
+	applicationProviderDisplayDataAttrTypes = map[string]attr.Type{
+		"description":  types.StringType,
+		"display_name": types.StringType,
+		"icon_url":     types.StringType,
+	}
+
+	applicationProviderAttrTypes = map[string]attr.Type{
+		"application_provider_arn": types.StringType,
+		"display_data":             types.ListType{ElemType: types.ObjectType{AttrTypes: applicationProviderDisplayDataAttrTypes}},
+		"federation_protocol":      types.StringType,
+	}
+)
+
+// @FrameworkDataSource(name="Application Providers")
+func newDataSourceApplicationProviders(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceApplicationProviders{}, nil
+}
+
+type dataSourceApplicationProviders struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceApplicationProviders) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_application_providers"
+}
+
+func (d *dataSourceApplicationProviders) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_providers": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: applicationProviderAttrTypes},
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceApplicationProviders) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceApplicationProvidersData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SSOAdminConn(ctx)
+
+	var elements []attr.Value
+	err := conn.ListApplicationProvidersPagesWithContext(ctx, &ssoadmin.ListApplicationProvidersInput{}, func(page *ssoadmin.ListApplicationProvidersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ApplicationProviders {
+			if v == nil {
+				continue
+			}
+
+			elements = append(elements, types.ObjectValueMust(applicationProviderAttrTypes, map[string]attr.Value{
+				"application_provider_arn": flex.StringToFramework(ctx, v.ApplicationProviderArn),
+				"display_data":             flattenApplicationProviderDisplayData(ctx, v.DisplayData),
+				"federation_protocol":      flex.StringToFramework(ctx, v.FederationProtocol),
+			}))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("listing SSO Application Providers", err.Error())
+
+		return
+	}
+
+	data.ApplicationProviders = types.ListValueMust(types.ObjectType{AttrTypes: applicationProviderAttrTypes}, elements)
+	data.ID = types.StringValue(d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceApplicationProvidersData struct {
+	ApplicationProviders types.List   `tfsdk:"application_providers"`
+	ID                   types.String `tfsdk:"id"`
+}
+
+func flattenApplicationProviderDisplayData(ctx context.Context, apiObject *ssoadmin.DisplayData) types.List {
+	elementType := types.ObjectType{AttrTypes: applicationProviderDisplayDataAttrTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(applicationProviderDisplayDataAttrTypes, map[string]attr.Value{
+			"description":  flex.StringToFramework(ctx, apiObject.Description),
+			"display_name": flex.StringToFramework(ctx, apiObject.DisplayName),
+			"icon_url":     flex.StringToFramework(ctx, apiObject.IconUrl),
+		}),
+	})
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+// Exports for use in tests only.
+var (
+	ResourceApplication                        = newResourceApplication
+	ResourceApplicationAccessScope             = newResourceApplicationAccessScope
+	ResourceApplicationAssignment              = newResourceApplicationAssignment
+	ResourceApplicationAssignmentConfiguration = newResourceApplicationAssignmentConfiguration
+	ResourceTrustedTokenIssuer                 = newResourceTrustedTokenIssuer
+)
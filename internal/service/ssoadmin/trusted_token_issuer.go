@@ -0,0 +1,419 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Trusted Token Issuer")
+// @Tags
+func newResourceTrustedTokenIssuer(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceTrustedTokenIssuer{}, nil
+}
+
+type resourceTrustedTokenIssuer struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceTrustedTokenIssuer) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_trusted_token_issuer"
+}
+
+func (r *resourceTrustedTokenIssuer) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": framework.ARNAttributeComputedOnly(),
+			"id":  framework.IDAttribute(),
+			"instance_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"trusted_token_issuer_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ssoadmin.TrustedTokenIssuerType_Values()...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"trusted_token_issuer_configuration": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"oidc_jwt_configuration": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"claim_attribute_path": schema.StringAttribute{
+										Required: true,
+									},
+									"identity_store_attribute_path": schema.StringAttribute{
+										Required: true,
+									},
+									"issuer_url": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									"jwks_retrieval_option": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(ssoadmin.JwksRetrievalOption_Values()...),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceTrustedTokenIssuer) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceTrustedTokenIssuerData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	input := &ssoadmin.CreateTrustedTokenIssuerInput{
+		InstanceArn:                     flex.StringFromFramework(ctx, data.InstanceARN),
+		Name:                            flex.StringFromFramework(ctx, data.Name),
+		Tags:                            getTagsIn(ctx),
+		TrustedTokenIssuerConfiguration: flex.ExpandFrameworkListNestedBlockPtr(ctx, data.TrustedTokenIssuerConfiguration, r.expandTrustedTokenIssuerConfiguration),
+		TrustedTokenIssuerType:          flex.StringFromFramework(ctx, data.TrustedTokenIssuerType),
+	}
+
+	output, err := conn.CreateTrustedTokenIssuerWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSO Trusted Token Issuer (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	arn := aws.StringValue(output.TrustedTokenIssuerArn)
+	data.ARN = types.StringValue(arn)
+	data.ID = types.StringValue(arn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTrustedTokenIssuer) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceTrustedTokenIssuerData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	output, err := FindTrustedTokenIssuerByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Trusted Token Issuer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The instance ARN isn't returned by the API, so on import it is derived from the
+	// trusted token issuer ARN: arn:${Partition}:sso::${Account}:trustedTokenIssuer/${InstanceId}/${TrustedTokenIssuerId}.
+	if data.InstanceARN.IsNull() || data.InstanceARN.ValueString() == "" {
+		instanceARN, err := trustedTokenIssuerInstanceARN(data.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("parsing SSO Trusted Token Issuer (%s) ARN", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		data.InstanceARN = types.StringValue(instanceARN)
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.TrustedTokenIssuerArn)
+	data.Name = flex.StringToFramework(ctx, output.Name)
+	data.TrustedTokenIssuerConfiguration = r.flattenTrustedTokenIssuerConfiguration(ctx, output.TrustedTokenIssuerConfiguration)
+	data.TrustedTokenIssuerType = flex.StringToFramework(ctx, output.TrustedTokenIssuerType)
+
+	// Tags are scoped to the Identity Center instance.
+	tags, err := listTags(ctx, conn, data.ID.ValueString(), data.InstanceARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for SSO Trusted Token Issuer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTrustedTokenIssuer) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceTrustedTokenIssuerData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	if !new.Name.Equal(old.Name) || !new.TrustedTokenIssuerConfiguration.Equal(old.TrustedTokenIssuerConfiguration) {
+		input := &ssoadmin.UpdateTrustedTokenIssuerInput{
+			Name:                  flex.StringFromFramework(ctx, new.Name),
+			TrustedTokenIssuerArn: flex.StringFromFramework(ctx, new.ID),
+		}
+
+		if v := flex.ExpandFrameworkListNestedBlockPtr(ctx, new.TrustedTokenIssuerConfiguration, r.expandTrustedTokenIssuerConfiguration); v != nil && v.OidcJwtConfiguration != nil {
+			input.TrustedTokenIssuerConfiguration = &ssoadmin.TrustedTokenIssuerUpdateConfiguration{
+				OidcJwtConfiguration: &ssoadmin.OidcJwtUpdateConfiguration{
+					ClaimAttributePath:         v.OidcJwtConfiguration.ClaimAttributePath,
+					IdentityStoreAttributePath: v.OidcJwtConfiguration.IdentityStoreAttributePath,
+					JwksRetrievalOption:        v.OidcJwtConfiguration.JwksRetrievalOption,
+				},
+			}
+		}
+
+		_, err := conn.UpdateTrustedTokenIssuerWithContext(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SSO Trusted Token Issuer (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.TagsAll.Equal(old.TagsAll) {
+		if err := updateTags(ctx, conn, new.ID.ValueString(), new.InstanceARN.ValueString(), old.TagsAll, new.TagsAll); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating tags for SSO Trusted Token Issuer (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceTrustedTokenIssuer) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceTrustedTokenIssuerData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	tflog.Debug(ctx, "deleting SSO Trusted Token Issuer", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteTrustedTokenIssuerWithContext(ctx, &ssoadmin.DeleteTrustedTokenIssuerInput{
+		TrustedTokenIssuerArn: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSO Trusted Token Issuer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceTrustedTokenIssuer) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (r *resourceTrustedTokenIssuer) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func (r *resourceTrustedTokenIssuer) expandTrustedTokenIssuerConfiguration(ctx context.Context, data trustedTokenIssuerConfigurationData) *ssoadmin.TrustedTokenIssuerConfiguration {
+	return &ssoadmin.TrustedTokenIssuerConfiguration{
+		OidcJwtConfiguration: flex.ExpandFrameworkListNestedBlockPtr(ctx, data.OIDCJWTConfiguration, r.expandOIDCJWTConfiguration),
+	}
+}
+
+func (r *resourceTrustedTokenIssuer) expandOIDCJWTConfiguration(ctx context.Context, data oidcJWTConfigurationData) *ssoadmin.OidcJwtConfiguration {
+	return &ssoadmin.OidcJwtConfiguration{
+		ClaimAttributePath:         flex.StringFromFramework(ctx, data.ClaimAttributePath),
+		IdentityStoreAttributePath: flex.StringFromFramework(ctx, data.IdentityStoreAttributePath),
+		IssuerUrl:                  flex.StringFromFramework(ctx, data.IssuerURL),
+		JwksRetrievalOption:        flex.StringFromFramework(ctx, data.JWKSRetrievalOption),
+	}
+}
+
+func (r *resourceTrustedTokenIssuer) flattenTrustedTokenIssuerConfiguration(ctx context.Context, apiObject *ssoadmin.TrustedTokenIssuerConfiguration) types.List {
+	attributeTypes := flex.AttributeTypesMust[trustedTokenIssuerConfigurationData](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"oidc_jwt_configuration": r.flattenOIDCJWTConfiguration(ctx, apiObject.OidcJwtConfiguration),
+		}),
+	})
+}
+
+func (r *resourceTrustedTokenIssuer) flattenOIDCJWTConfiguration(ctx context.Context, apiObject *ssoadmin.OidcJwtConfiguration) types.List {
+	attributeTypes := flex.AttributeTypesMust[oidcJWTConfigurationData](ctx)
+	elementType := types.ObjectType{AttrTypes: attributeTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"claim_attribute_path":          flex.StringToFramework(ctx, apiObject.ClaimAttributePath),
+			"identity_store_attribute_path": flex.StringToFramework(ctx, apiObject.IdentityStoreAttributePath),
+			"issuer_url":                    flex.StringToFramework(ctx, apiObject.IssuerUrl),
+			"jwks_retrieval_option":         flex.StringToFramework(ctx, apiObject.JwksRetrievalOption),
+		}),
+	})
+}
+
+// See https://docs.aws.amazon.com/singlesignon/latest/APIReference/API_DescribeTrustedTokenIssuer.html.
+type resourceTrustedTokenIssuerData struct {
+	ARN                             types.String `tfsdk:"arn"`
+	ID                              types.String `tfsdk:"id"`
+	InstanceARN                     types.String `tfsdk:"instance_arn"`
+	Name                            types.String `tfsdk:"name"`
+	Tags                            types.Map    `tfsdk:"tags"`
+	TagsAll                         types.Map    `tfsdk:"tags_all"`
+	TrustedTokenIssuerConfiguration types.List   `tfsdk:"trusted_token_issuer_configuration"`
+	TrustedTokenIssuerType          types.String `tfsdk:"trusted_token_issuer_type"`
+}
+
+type trustedTokenIssuerConfigurationData struct {
+	OIDCJWTConfiguration types.List `tfsdk:"oidc_jwt_configuration"`
+}
+
+type oidcJWTConfigurationData struct {
+	ClaimAttributePath         types.String `tfsdk:"claim_attribute_path"`
+	IdentityStoreAttributePath types.String `tfsdk:"identity_store_attribute_path"`
+	IssuerURL                  types.String `tfsdk:"issuer_url"`
+	JWKSRetrievalOption        types.String `tfsdk:"jwks_retrieval_option"`
+}
+
+func FindTrustedTokenIssuerByARN(ctx context.Context, conn *ssoadmin.SSOAdmin, arn string) (*ssoadmin.DescribeTrustedTokenIssuerOutput, error) {
+	input := &ssoadmin.DescribeTrustedTokenIssuerInput{
+		TrustedTokenIssuerArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeTrustedTokenIssuerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func trustedTokenIssuerInstanceARN(trustedTokenIssuerARN string) (string, error) {
+	parsedARN, err := arn.Parse(trustedTokenIssuerARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	// trustedTokenIssuer/${InstanceId}/${TrustedTokenIssuerId}
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if n := len(parts); n != 3 || parts[1] == "" {
+		return "", fmt.Errorf("unexpected format for ARN resource (%s)", parsedARN.Resource)
+	}
+
+	return arn.ARN{
+		Partition: parsedARN.Partition,
+		Service:   parsedARN.Service,
+		Resource:  "instance/" + parts[1],
+	}.String(), nil
+}
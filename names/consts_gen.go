@@ -25,6 +25,8 @@ const (
 	AutoScalingPlans             = "autoscalingplans"
 	Backup                       = "backup"
 	Batch                        = "batch"
+	Bedrock                      = "bedrock"
+	BedrockAgent                 = "bedrockagent"
 	Budgets                      = "budgets"
 	CE                           = "ce"
 	CUR                          = "cur"
@@ -36,6 +38,7 @@ const (
 	CloudControl                 = "cloudcontrol"
 	CloudFormation               = "cloudformation"
 	CloudFront                   = "cloudfront"
+	CloudFrontKeyValueStore      = "cloudfrontkeyvaluestore"
 	CloudHSMV2                   = "cloudhsmv2"
 	CloudSearch                  = "cloudsearch"
 	CloudTrail                   = "cloudtrail"
@@ -161,6 +164,7 @@ const (
 	RolesAnywhere                = "rolesanywhere"
 	Route53                      = "route53"
 	Route53Domains               = "route53domains"
+	Route53Profiles              = "route53profiles"
 	Route53RecoveryControlConfig = "route53recoverycontrolconfig"
 	Route53RecoveryReadiness     = "route53recoveryreadiness"
 	Route53Resolver              = "route53resolver"
@@ -29,7 +29,10 @@ const (
 	AccountEndpointID                    = "account"
 	ACMEndpointID                        = "acm"
 	AuditManagerEndpointID               = "auditmanager"
+	BedrockEndpointID                    = "bedrock"
+	BedrockAgentEndpointID               = "bedrock-agent"
 	CleanRoomsEndpointID                 = "cleanrooms"
+	CloudFrontKeyValueStoreEndpointID    = "cloudfront-keyvaluestore"
 	CloudWatchLogsEndpointID             = "logs"
 	ComprehendEndpointID                 = "comprehend"
 	ComputeOptimizerEndpointID           = "computeoptimizer"
@@ -52,7 +55,9 @@ const (
 	ResourceExplorer2EndpointID          = "resource-explorer-2"
 	RolesAnywhereEndpointID              = "rolesanywhere"
 	Route53DomainsEndpointID             = "route53domains"
+	Route53ProfilesEndpointID            = "route53profiles"
 	SchedulerEndpointID                  = "scheduler"
+	SecurityLakeEndpointID               = "securitylake"
 	SESV2EndpointID                      = "sesv2"
 	SSMEndpointID                        = "ssm"
 	SSMContactsEndpointID                = "ssm-contacts"